@@ -0,0 +1,252 @@
+package cache
+
+import (
+	"time"
+)
+
+// arc holds an adaptive replacement cache which balances between recently and frequently used entries
+// Entries which were used once are held in t1 and entries which were used more than once in t2. The keys of entries which were evicted of t1 and t2 are remembered in the ghost lists b1 and b2. A hit in a ghost list shifts the target size p of t1 towards the list that would have held the key.
+type arc[K comparable, V any] struct {
+	base[K, V]
+
+	entries map[K]*entry[K, V] // The index of all entries including the ghost entries
+	t1      list[K, V]         // Entries which were used once
+	t2      list[K, V]         // Entries which were used more than once
+	b1      list[K, V]         // Ghost entries which were evicted of t1
+	b2      list[K, V]         // Ghost entries which were evicted of t2
+	p       int                // The target size of t1
+}
+
+// NewARC returns a new ARC cache which holds at most capacity entries
+func NewARC(capacity int, opts ...Option[interface{}, interface{}]) *arc[interface{}, interface{}] {
+	return NewARCOf(capacity, opts...)
+}
+
+// NewARCOf returns a new ARC cache for keys of type K and values of type V which holds at most capacity entries
+func NewARCOf[K comparable, V any](capacity int, opts ...Option[K, V]) *arc[K, V] {
+	c := new(arc[K, V])
+
+	c.init(capacity, opts)
+
+	c.Clear()
+
+	return c
+}
+
+// Clear removes all entries and resets the statistics of the cache
+func (c *arc[K, V]) Clear() {
+	c.entries = make(map[K]*entry[K, V])
+	c.t1.init()
+	c.t2.init()
+	c.b1.init()
+	c.b2.init()
+	c.p = 0
+	c.stats = Stats{}
+}
+
+// Len returns the current entry count including entries which are expired but not yet removed
+func (c *arc[K, V]) Len() int {
+	return c.t1.len + c.t2.len
+}
+
+// resident returns true if the given entry holds a value and is not a ghost entry
+func (c *arc[K, V]) resident(e *entry[K, V]) bool {
+	return e.list == &c.t1 || e.list == &c.t2
+}
+
+// getEntry returns the resident entry of the given key, or nil if there is no such key
+// Expired entries are removed.
+func (c *arc[K, V]) getEntry(k K) *entry[K, V] {
+	e, ok := c.entries[k]
+
+	if !ok || !c.resident(e) {
+		return nil
+	}
+
+	if c.expired(e) {
+		c.removeEntry(e)
+		c.evicted(e, true)
+
+		return nil
+	}
+
+	return e
+}
+
+// removeEntry removes the given entry from the cache
+func (c *arc[K, V]) removeEntry(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	e.list.remove(e)
+}
+
+// demote moves the least recently used entry of the given resident list to the front of the given ghost list
+func (c *arc[K, V]) demote(from *list[K, V], to *list[K, V]) {
+	e := from.back()
+
+	from.remove(e)
+	c.evicted(e, c.expired(e))
+
+	var v V
+
+	e.value = v
+	e.expires = time.Time{}
+
+	to.pushFront(e)
+}
+
+// dropGhost forgets the least recently used key of the given ghost list
+func (c *arc[K, V]) dropGhost(l *list[K, V]) {
+	if e := l.back(); e != nil {
+		c.removeEntry(e)
+	}
+}
+
+// replace makes room for one entry by demoting an entry of t1 or t2 if the cache is full
+func (c *arc[K, V]) replace(inB2 bool) {
+	if c.t1.len+c.t2.len < c.capacity {
+		return
+	}
+
+	if c.t1.len > 0 && (c.t1.len > c.p || (inB2 && c.t1.len == c.p) || c.t2.len == 0) {
+		c.demote(&c.t1, &c.b1)
+	} else {
+		c.demote(&c.t2, &c.b2)
+	}
+}
+
+// Get returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Get counts as hit or miss and marks the key as frequently used.
+func (c *arc[K, V]) Get(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		c.stats.Misses++
+
+		var v V
+
+		return v, false
+	}
+
+	c.stats.Hits++
+
+	e.list.remove(e)
+	c.t2.pushFront(e)
+
+	return e.value, true
+}
+
+// Peek returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Peek neither counts as hit or miss nor marks the key as used.
+func (c *arc[K, V]) Peek(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	return e.value, true
+}
+
+// Contains returns true if the given key exists and is not expired, or false if it does not
+func (c *arc[K, V]) Contains(k K) bool {
+	return c.getEntry(k) != nil
+}
+
+// Put sets the value of the given key and evicts an entry if the cache is full
+// Putting an existing key or a key which was recently evicted marks the key as frequently used.
+func (c *arc[K, V]) Put(k K, v V) {
+	e, ok := c.entries[k]
+
+	if ok {
+		switch e.list {
+		case &c.b1:
+			c.p = min(c.capacity, c.p+max(1, c.b2.len/c.b1.len))
+
+			c.replace(false)
+		case &c.b2:
+			c.p = max(0, c.p-max(1, c.b1.len/c.b2.len))
+
+			c.replace(true)
+		}
+
+		c.setValue(e, v)
+
+		e.list.remove(e)
+		c.t2.pushFront(e)
+
+		return
+	}
+
+	if l1 := c.t1.len + c.b1.len; l1 >= c.capacity {
+		if c.t1.len < c.capacity {
+			c.dropGhost(&c.b1)
+
+			c.replace(false)
+		} else {
+			e := c.t1.back()
+
+			c.removeEntry(e)
+			c.evicted(e, c.expired(e))
+		}
+	} else if l1+c.t2.len+c.b2.len >= c.capacity {
+		if l1+c.t2.len+c.b2.len >= 2*c.capacity {
+			c.dropGhost(&c.b2)
+		}
+
+		c.replace(false)
+	}
+
+	e = &entry[K, V]{
+		key: k,
+	}
+
+	c.setValue(e, v)
+
+	c.entries[k] = e
+	c.t1.pushFront(e)
+}
+
+// Remove removes the given key and returns its value and true, or false if there is no such key or if the key is expired
+func (c *arc[K, V]) Remove(k K) (V, bool) {
+	e, ok := c.entries[k]
+
+	if ok && !c.resident(e) {
+		c.removeEntry(e)
+	}
+
+	e = c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	c.removeEntry(e)
+
+	return e.value, true
+}
+
+// RemoveExpired removes all expired entries and returns their count
+func (c *arc[K, V]) RemoveExpired() int {
+	n := 0
+
+	for _, l := range []*list[K, V]{&c.t1, &c.t2} {
+		for e := l.back(); e != nil && e != &l.root; {
+			p := e.previous
+
+			if c.expired(e) {
+				c.removeEntry(e)
+				c.evicted(e, true)
+
+				n++
+			}
+
+			e = p
+		}
+	}
+
+	return n
+}
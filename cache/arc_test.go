@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+func TestARCScanResistance(t *testing.T) {
+	a := NewARCOf[int, int](4)
+	l := NewLRUOf[int, int](4)
+
+	for _, c := range []Cache[int, int]{a, l} {
+		c.Put(1, 1)
+		c.Put(2, 2)
+
+		c.Get(1)
+		c.Get(2)
+
+		// keys which are used only once do not evict frequently used keys
+		for i := 10; i < 20; i++ {
+			c.Put(i, i)
+		}
+	}
+
+	True(t, a.Contains(1))
+	True(t, a.Contains(2))
+	Equal(t, a.t2.len, 2)
+	Equal(t, a.t1.len, 2)
+
+	False(t, l.Contains(1))
+	False(t, l.Contains(2))
+}
+
+func TestARCGhosts(t *testing.T) {
+	c := NewARCOf[int, int](2)
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+
+	c.Get(2)
+
+	c.Put(3, 3)
+
+	// evicted keys are remembered without their values
+	False(t, c.Contains(1))
+	True(t, c.entries[1].list == &c.b1)
+	Equal(t, c.entries[1].value, 0)
+	Equal(t, c.p, 0)
+
+	// a hit in b1 grows the target size of t1
+	c.Put(1, 10)
+
+	Equal(t, c.p, 1)
+	True(t, c.entries[1].list == &c.t2)
+	True(t, c.entries[2].list == &c.b2)
+
+	v, ok := c.Get(1)
+	True(t, ok)
+	Equal(t, v, 10)
+
+	// a hit in b2 shrinks the target size of t1
+	c.Put(2, 20)
+
+	Equal(t, c.p, 0)
+	True(t, c.entries[2].list == &c.t2)
+	True(t, c.entries[3].list == &c.b1)
+
+	// removing a remembered key forgets it
+	_, ok = c.Remove(3)
+	False(t, ok)
+
+	_, ok = c.entries[3]
+	False(t, ok)
+	Equal(t, c.b1.len+c.b2.len, 0)
+
+	// new keys evict of t1 without remembering them if t1 and b1 are full
+	c = NewARCOf[int, int](2)
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	_, ok = c.entries[1]
+	False(t, ok)
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"time"
+)
+
+// Cache defines a cache which holds a limited number of values identified by keys
+// If a cache is full, putting a new key evicts an entry which is chosen by the cache's policy.
+type Cache[K comparable, V any] interface {
+	// Clear removes all entries and resets the statistics of the cache
+	Clear()
+	// Len returns the current entry count including entries which are expired but not yet removed
+	Len() int
+	// Cap returns the maximum entry count
+	Cap() int
+
+	// Get returns the value of the given key and true, or false if there is no such key or if the key is expired
+	// Get counts as hit or miss and marks the key as used.
+	Get(k K) (V, bool)
+	// Peek returns the value of the given key and true, or false if there is no such key or if the key is expired
+	// Peek neither counts as hit or miss nor marks the key as used.
+	Peek(k K) (V, bool)
+	// Contains returns true if the given key exists and is not expired, or false if it does not
+	Contains(k K) bool
+
+	// Put sets the value of the given key and evicts an entry if the cache is full
+	Put(k K, v V)
+	// Remove removes the given key and returns its value and true, or false if there is no such key or if the key is expired
+	Remove(k K) (V, bool)
+	// RemoveExpired removes all expired entries and returns their count
+	RemoveExpired() int
+
+	// Stats returns the statistics of the cache
+	Stats() Stats
+}
+
+// Stats holds the statistics of a cache
+type Stats struct {
+	Hits        int // The count of successful lookups
+	Misses      int // The count of failed lookups
+	Evictions   int // The count of entries which were evicted to make room for other entries
+	Expirations int // The count of entries which were removed because they expired
+}
+
+// config holds the options of a cache
+type config[K comparable, V any] struct {
+	onEvict func(k K, v V)   // Called for every evicted or expired entry
+	ttl     time.Duration    // The time to live of entries, or zero if entries do not expire
+	now     func() time.Time // Returns the current time for expiring entries
+}
+
+// Option defines an option for creating a cache
+type Option[K comparable, V any] func(c *config[K, V])
+
+// WithOnEvict sets a function which is called for every entry which is evicted or which expired
+func WithOnEvict[K comparable, V any](onEvict func(k K, v V)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = onEvict
+	}
+}
+
+// WithTTL sets the time to live of entries which starts whenever the value of an entry is put
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithClock sets the function which returns the current time for expiring entries, e.g. to control the time in tests
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.now = now
+	}
+}
+
+// entry holds a single entry of a cache
+type entry[K comparable, V any] struct {
+	previous *entry[K, V]  // The previous entry in the list of the entry
+	next     *entry[K, V]  // The next entry in the list of the entry
+	list     *list[K, V]   // The list holding the entry
+	key      K             // The key of the entry
+	value    V             // The value of the entry
+	expires  time.Time     // The time at which the entry expires, or zero if it does not expire
+	bucket   *bucket[K, V] // The frequency bucket of the entry, used only by LFU caches
+}
+
+// list holds a doubly linked list of entries whose front is the most recently used entry
+type list[K comparable, V any] struct {
+	root entry[K, V] // The sentinel of the list, root.next is the front and root.previous the back
+	len  int         // The current entry count
+}
+
+// init resets the list to zero entries
+func (l *list[K, V]) init() {
+	l.root.next = &l.root
+	l.root.previous = &l.root
+	l.len = 0
+}
+
+// back returns the least recently used entry, or nil if the list is empty
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.previous
+}
+
+// pushFront inserts the given entry at the front of the list
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.previous = &l.root
+	e.next = l.root.next
+	e.previous.next = e
+	e.next.previous = e
+	e.list = l
+
+	l.len++
+}
+
+// remove removes the given entry from the list
+func (l *list[K, V]) remove(e *entry[K, V]) {
+	e.previous.next = e.next
+	e.next.previous = e.previous
+	e.previous = nil
+	e.next = nil
+	e.list = nil
+
+	l.len--
+}
+
+// moveToFront moves the given entry of the list to its front
+func (l *list[K, V]) moveToFront(e *entry[K, V]) {
+	l.remove(e)
+	l.pushFront(e)
+}
+
+// base holds everything which all caches share
+type base[K comparable, V any] struct {
+	config[K, V]
+
+	capacity int   // The maximum entry count
+	stats    Stats // The statistics of the cache
+}
+
+// init sets up the capacity and options of the cache
+func (b *base[K, V]) init(capacity int, opts []Option[K, V]) {
+	if capacity < 1 {
+		panic("capacity must be at least 1")
+	}
+
+	b.capacity = capacity
+	b.now = time.Now
+
+	for _, o := range opts {
+		o(&b.config)
+	}
+}
+
+// Cap returns the maximum entry count
+func (b *base[K, V]) Cap() int {
+	return b.capacity
+}
+
+// Stats returns the statistics of the cache
+func (b *base[K, V]) Stats() Stats {
+	return b.stats
+}
+
+// setValue sets the value of the given entry and restarts its time to live
+func (b *base[K, V]) setValue(e *entry[K, V], v V) {
+	e.value = v
+
+	if b.ttl > 0 {
+		e.expires = b.now().Add(b.ttl)
+	}
+}
+
+// expired returns true if the given entry is expired
+func (b *base[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expires.IsZero() && !b.now().Before(e.expires)
+}
+
+// evicted counts the given entry as evicted or expired and calls the eviction function
+func (b *base[K, V]) evicted(e *entry[K, V], expired bool) {
+	if expired {
+		b.stats.Expirations++
+	} else {
+		b.stats.Evictions++
+	}
+
+	if b.onEvict != nil {
+		b.onEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,347 @@
+package cache
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+var _ Cache[interface{}, interface{}] = NewLRU(1)
+var _ Cache[interface{}, interface{}] = NewLFU(1)
+var _ Cache[interface{}, interface{}] = NewARC(1)
+
+// clock holds a time which only changes if it is advanced
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+var variants = []struct {
+	name       string
+	new        func(capacity int, opts ...Option[int, int]) Cache[int, int]
+	invariants func(t *testing.T, c Cache[int, int])
+}{
+	{"lru", func(capacity int, opts ...Option[int, int]) Cache[int, int] {
+		return NewLRUOf(capacity, opts...)
+	}, func(t *testing.T, c Cache[int, int]) {
+		l := c.(*lru[int, int])
+
+		checkList(t, &l.list)
+		Equal(t, len(l.entries), l.list.len)
+		True(t, l.list.len <= l.capacity)
+	}},
+	{"lfu", func(capacity int, opts ...Option[int, int]) Cache[int, int] {
+		return NewLFUOf(capacity, opts...)
+	}, func(t *testing.T, c Cache[int, int]) {
+		l := c.(*lfu[int, int])
+
+		n := 0
+		f := 0
+
+		for b := l.buckets.next; b != &l.buckets; b = b.next {
+			True(t, b.frequency > f)
+			True(t, b.entries.len > 0)
+			True(t, b.next.previous == b)
+
+			checkList(t, &b.entries)
+
+			for e := b.entries.root.next; e != &b.entries.root; e = e.next {
+				True(t, e.bucket == b)
+			}
+
+			f = b.frequency
+			n += b.entries.len
+		}
+
+		Equal(t, len(l.entries), n)
+		True(t, n <= l.capacity)
+	}},
+	{"arc", func(capacity int, opts ...Option[int, int]) Cache[int, int] {
+		return NewARCOf(capacity, opts...)
+	}, func(t *testing.T, c Cache[int, int]) {
+		a := c.(*arc[int, int])
+
+		for _, l := range []*list[int, int]{&a.t1, &a.t2, &a.b1, &a.b2} {
+			checkList(t, l)
+		}
+
+		Equal(t, len(a.entries), a.t1.len+a.t2.len+a.b1.len+a.b2.len)
+		True(t, a.t1.len+a.t2.len <= a.capacity)
+		True(t, a.t1.len+a.b1.len <= a.capacity)
+		True(t, len(a.entries) <= 2*a.capacity)
+		True(t, a.p >= 0 && a.p <= a.capacity)
+	}},
+}
+
+// checkList checks the links of the given list
+func checkList(t *testing.T, l *list[int, int]) {
+	n := 0
+
+	for e := l.root.next; e != &l.root; e = e.next {
+		True(t, e.next.previous == e)
+		True(t, e.list == l)
+
+		n++
+	}
+
+	Equal(t, n, l.len)
+}
+
+func TestBasic(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			c := variant.new(3)
+
+			Equal(t, c.Len(), 0)
+			Equal(t, c.Cap(), 3)
+
+			v, ok := c.Get(1)
+			False(t, ok)
+			Equal(t, v, 0)
+
+			c.Put(1, 10)
+			c.Put(2, 20)
+
+			v, ok = c.Get(1)
+			True(t, ok)
+			Equal(t, v, 10)
+
+			v, ok = c.Peek(2)
+			True(t, ok)
+			Equal(t, v, 20)
+
+			True(t, c.Contains(2))
+			False(t, c.Contains(3))
+
+			c.Put(2, 21)
+
+			v, ok = c.Get(2)
+			True(t, ok)
+			Equal(t, v, 21)
+			Equal(t, c.Len(), 2)
+
+			v, ok = c.Remove(1)
+			True(t, ok)
+			Equal(t, v, 10)
+
+			_, ok = c.Remove(1)
+			False(t, ok)
+			False(t, c.Contains(1))
+			Equal(t, c.Len(), 1)
+
+			// Peek and Contains do not count
+			Equal(t, c.Stats(), Stats{
+				Hits:   2,
+				Misses: 1,
+			})
+
+			variant.invariants(t, c)
+
+			c.Clear()
+
+			Equal(t, c.Len(), 0)
+			False(t, c.Contains(2))
+			Equal(t, c.Stats(), Stats{})
+
+			variant.invariants(t, c)
+		})
+	}
+}
+
+func TestEviction(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			evicted := map[int]int{}
+
+			c := variant.new(5, WithOnEvict(func(k int, v int) {
+				evicted[k] = v
+			}))
+
+			for i := 0; i < 20; i++ {
+				c.Put(i, i*10)
+
+				True(t, c.Len() <= 5)
+
+				variant.invariants(t, c)
+			}
+
+			Equal(t, c.Len(), 5)
+			Equal(t, c.Stats().Evictions, 15)
+			Equal(t, len(evicted), 15)
+
+			for k, v := range evicted {
+				Equal(t, v, k*10)
+				False(t, c.Contains(k))
+			}
+
+			// removing does not count as eviction
+			for i := 0; i < 20; i++ {
+				c.Remove(i)
+			}
+
+			Equal(t, c.Len(), 0)
+			Equal(t, c.Stats().Evictions, 15)
+			Equal(t, len(evicted), 15)
+
+			variant.invariants(t, c)
+		})
+	}
+}
+
+func TestTTL(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			now := &clock{
+				now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			}
+			evicted := []int{}
+
+			c := variant.new(5, WithTTL[int, int](time.Minute), WithClock[int, int](now.Now), WithOnEvict(func(k int, v int) {
+				evicted = append(evicted, k)
+			}))
+
+			c.Put(1, 10)
+			c.Put(2, 20)
+
+			now.Advance(30 * time.Second)
+
+			c.Put(3, 30)
+
+			// putting a value restarts its time to live
+			c.Put(1, 11)
+
+			v, ok := c.Get(2)
+			True(t, ok)
+			Equal(t, v, 20)
+
+			now.Advance(30 * time.Second)
+
+			_, ok = c.Get(2)
+			False(t, ok)
+			False(t, c.Contains(2))
+			Equal(t, c.Len(), 2)
+			Equal(t, evicted, []int{2})
+
+			v, ok = c.Get(1)
+			True(t, ok)
+			Equal(t, v, 11)
+
+			now.Advance(30 * time.Second)
+
+			// expired entries stay until they are looked up or removed
+			Equal(t, c.Len(), 2)
+			Equal(t, c.RemoveExpired(), 2)
+			Equal(t, c.Len(), 0)
+			Equal(t, len(evicted), 3)
+
+			_, ok = c.Remove(1)
+			False(t, ok)
+
+			Equal(t, c.Stats(), Stats{
+				Hits:        2,
+				Misses:      1,
+				Expirations: 3,
+			})
+
+			variant.invariants(t, c)
+
+			// expired entries are removed on lookups
+			c.Put(4, 40)
+
+			now.Advance(time.Minute)
+
+			_, ok = c.Peek(4)
+			False(t, ok)
+			Equal(t, c.Len(), 0)
+			Equal(t, c.Stats().Expirations, 4)
+
+			variant.invariants(t, c)
+		})
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+
+			// the model holds the last value of every key which is in the cache
+			model := map[int]int{}
+
+			c := variant.new(16, WithOnEvict(func(k int, v int) {
+				Equal(t, model[k], v)
+
+				delete(model, k)
+			}))
+
+			hits := 0
+			misses := 0
+
+			for i := 0; i < 5000; i++ {
+				k := r.Intn(40)
+
+				switch r.Intn(5) {
+				case 0, 1:
+					c.Put(k, i)
+
+					model[k] = i
+				case 2, 3:
+					v, ok := c.Get(k)
+
+					w, found := model[k]
+					Equal(t, ok, found)
+
+					if ok {
+						Equal(t, v, w)
+
+						hits++
+					} else {
+						misses++
+					}
+				case 4:
+					v, ok := c.Remove(k)
+
+					w, found := model[k]
+					Equal(t, ok, found)
+					Equal(t, v, w)
+
+					delete(model, k)
+				}
+
+				Equal(t, c.Len(), len(model))
+
+				variant.invariants(t, c)
+			}
+
+			Equal(t, c.Stats().Hits, hits)
+			Equal(t, c.Stats().Misses, misses)
+		})
+	}
+}
+
+func BenchmarkGetPut(b *testing.B) {
+	for _, variant := range variants {
+		b.Run(variant.name, func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+
+			c := variant.new(1000)
+
+			for i := 0; i < b.N; i++ {
+				k := int(r.ExpFloat64() * 500)
+
+				if _, ok := c.Get(k); !ok {
+					c.Put(k, k)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package cache
+
+// bucket holds all entries of an LFU cache with the same use count
+type bucket[K comparable, V any] struct {
+	previous  *bucket[K, V] // The bucket with the next lower use count
+	next      *bucket[K, V] // The bucket with the next higher use count
+	frequency int           // The use count of all entries of the bucket
+	entries   list[K, V]    // The entries of the bucket ordered by their last use
+}
+
+// lfu holds a cache which evicts the least frequently used entry
+// Entries with the same use count are evicted in least recently used order. All operations are done in O(1).
+type lfu[K comparable, V any] struct {
+	base[K, V]
+
+	entries map[K]*entry[K, V] // The index of all entries
+	buckets bucket[K, V]       // The sentinel of the buckets, buckets.next has the lowest use count
+}
+
+// NewLFU returns a new LFU cache which holds at most capacity entries
+func NewLFU(capacity int, opts ...Option[interface{}, interface{}]) *lfu[interface{}, interface{}] {
+	return NewLFUOf(capacity, opts...)
+}
+
+// NewLFUOf returns a new LFU cache for keys of type K and values of type V which holds at most capacity entries
+func NewLFUOf[K comparable, V any](capacity int, opts ...Option[K, V]) *lfu[K, V] {
+	c := new(lfu[K, V])
+
+	c.init(capacity, opts)
+
+	c.Clear()
+
+	return c
+}
+
+// Clear removes all entries and resets the statistics of the cache
+func (c *lfu[K, V]) Clear() {
+	c.entries = make(map[K]*entry[K, V])
+	c.buckets.next = &c.buckets
+	c.buckets.previous = &c.buckets
+	c.stats = Stats{}
+}
+
+// Len returns the current entry count including entries which are expired but not yet removed
+func (c *lfu[K, V]) Len() int {
+	return len(c.entries)
+}
+
+// insertBucket inserts a new bucket with the given use count after the given bucket
+func (c *lfu[K, V]) insertBucket(p *bucket[K, V], frequency int) *bucket[K, V] {
+	b := &bucket[K, V]{
+		previous:  p,
+		next:      p.next,
+		frequency: frequency,
+	}
+
+	b.entries.init()
+
+	p.next.previous = b
+	p.next = b
+
+	return b
+}
+
+// removeFromBucket removes the given entry from its bucket and removes the bucket if it is empty
+func (c *lfu[K, V]) removeFromBucket(e *entry[K, V]) {
+	b := e.bucket
+
+	b.entries.remove(e)
+	e.bucket = nil
+
+	if b.entries.len == 0 {
+		b.previous.next = b.next
+		b.next.previous = b.previous
+	}
+}
+
+// touch increases the use count of the given entry
+func (c *lfu[K, V]) touch(e *entry[K, V]) {
+	b := e.bucket
+
+	n := b.next
+	if n == &c.buckets || n.frequency != b.frequency+1 {
+		n = c.insertBucket(b, b.frequency+1)
+	}
+
+	c.removeFromBucket(e)
+
+	n.entries.pushFront(e)
+	e.bucket = n
+}
+
+// getEntry returns the entry of the given key, or nil if there is no such key
+// Expired entries are removed.
+func (c *lfu[K, V]) getEntry(k K) *entry[K, V] {
+	e, ok := c.entries[k]
+
+	if !ok {
+		return nil
+	}
+
+	if c.expired(e) {
+		c.removeEntry(e)
+		c.evicted(e, true)
+
+		return nil
+	}
+
+	return e
+}
+
+// removeEntry removes the given entry from the cache
+func (c *lfu[K, V]) removeEntry(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	c.removeFromBucket(e)
+}
+
+// Get returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Get counts as hit or miss and increases the use count of the key.
+func (c *lfu[K, V]) Get(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		c.stats.Misses++
+
+		var v V
+
+		return v, false
+	}
+
+	c.stats.Hits++
+
+	c.touch(e)
+
+	return e.value, true
+}
+
+// Peek returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Peek neither counts as hit or miss nor increases the use count of the key.
+func (c *lfu[K, V]) Peek(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	return e.value, true
+}
+
+// Contains returns true if the given key exists and is not expired, or false if it does not
+func (c *lfu[K, V]) Contains(k K) bool {
+	return c.getEntry(k) != nil
+}
+
+// Frequency returns the use count of the given key, or zero if there is no such key or if the key is expired
+func (c *lfu[K, V]) Frequency(k K) int {
+	e := c.getEntry(k)
+
+	if e == nil {
+		return 0
+	}
+
+	return e.bucket.frequency
+}
+
+// Put sets the value of the given key and evicts the least frequently used entry if the cache is full
+// Putting an existing key increases its use count.
+func (c *lfu[K, V]) Put(k K, v V) {
+	if e, ok := c.entries[k]; ok {
+		c.setValue(e, v)
+		c.touch(e)
+
+		return
+	}
+
+	if len(c.entries) == c.capacity {
+		e := c.buckets.next.entries.back()
+
+		c.removeEntry(e)
+		c.evicted(e, c.expired(e))
+	}
+
+	e := &entry[K, V]{
+		key: k,
+	}
+
+	c.setValue(e, v)
+
+	b := c.buckets.next
+	if b == &c.buckets || b.frequency != 1 {
+		b = c.insertBucket(&c.buckets, 1)
+	}
+
+	c.entries[k] = e
+	b.entries.pushFront(e)
+	e.bucket = b
+}
+
+// Remove removes the given key and returns its value and true, or false if there is no such key or if the key is expired
+func (c *lfu[K, V]) Remove(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	c.removeEntry(e)
+
+	return e.value, true
+}
+
+// RemoveExpired removes all expired entries and returns their count
+func (c *lfu[K, V]) RemoveExpired() int {
+	n := 0
+
+	for b := c.buckets.next; b != &c.buckets; {
+		nb := b.next
+
+		for e := b.entries.back(); e != nil && e != &b.entries.root; {
+			p := e.previous
+
+			if c.expired(e) {
+				c.removeEntry(e)
+				c.evicted(e, true)
+
+				n++
+			}
+
+			e = p
+		}
+
+		b = nb
+	}
+
+	return n
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+func TestLFUOrder(t *testing.T) {
+	c := NewLFUOf[int, int](3)
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+
+	Equal(t, c.Frequency(1), 3)
+	Equal(t, c.Frequency(2), 2)
+	Equal(t, c.Frequency(3), 1)
+	Equal(t, c.Frequency(4), 0)
+
+	c.Put(4, 4)
+
+	False(t, c.Contains(3))
+
+	// new keys are evicted first if they are not used
+	c.Put(5, 5)
+
+	False(t, c.Contains(4))
+
+	// keys with the same use count are evicted in least recently used order
+	c.Get(5)
+
+	Equal(t, c.Frequency(5), 2)
+
+	c.Put(6, 6)
+
+	False(t, c.Contains(2))
+	True(t, c.Contains(1))
+	True(t, c.Contains(5))
+	True(t, c.Contains(6))
+
+	// putting an existing key counts as use
+	c.Put(6, 60)
+
+	Equal(t, c.Frequency(6), 2)
+}
@@ -0,0 +1,162 @@
+package cache
+
+// lru holds a cache which evicts the least recently used entry
+type lru[K comparable, V any] struct {
+	base[K, V]
+
+	entries map[K]*entry[K, V] // The index of all entries
+	list    list[K, V]         // All entries ordered by their last use
+}
+
+// NewLRU returns a new LRU cache which holds at most capacity entries
+func NewLRU(capacity int, opts ...Option[interface{}, interface{}]) *lru[interface{}, interface{}] {
+	return NewLRUOf(capacity, opts...)
+}
+
+// NewLRUOf returns a new LRU cache for keys of type K and values of type V which holds at most capacity entries
+func NewLRUOf[K comparable, V any](capacity int, opts ...Option[K, V]) *lru[K, V] {
+	c := new(lru[K, V])
+
+	c.init(capacity, opts)
+
+	c.Clear()
+
+	return c
+}
+
+// Clear removes all entries and resets the statistics of the cache
+func (c *lru[K, V]) Clear() {
+	c.entries = make(map[K]*entry[K, V])
+	c.list.init()
+	c.stats = Stats{}
+}
+
+// Len returns the current entry count including entries which are expired but not yet removed
+func (c *lru[K, V]) Len() int {
+	return c.list.len
+}
+
+// getEntry returns the entry of the given key, or nil if there is no such key
+// Expired entries are removed.
+func (c *lru[K, V]) getEntry(k K) *entry[K, V] {
+	e, ok := c.entries[k]
+
+	if !ok {
+		return nil
+	}
+
+	if c.expired(e) {
+		c.removeEntry(e)
+		c.evicted(e, true)
+
+		return nil
+	}
+
+	return e
+}
+
+// removeEntry removes the given entry from the cache
+func (c *lru[K, V]) removeEntry(e *entry[K, V]) {
+	delete(c.entries, e.key)
+	c.list.remove(e)
+}
+
+// Get returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Get counts as hit or miss and marks the key as used.
+func (c *lru[K, V]) Get(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		c.stats.Misses++
+
+		var v V
+
+		return v, false
+	}
+
+	c.stats.Hits++
+
+	c.list.moveToFront(e)
+
+	return e.value, true
+}
+
+// Peek returns the value of the given key and true, or false if there is no such key or if the key is expired
+// Peek neither counts as hit or miss nor marks the key as used.
+func (c *lru[K, V]) Peek(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	return e.value, true
+}
+
+// Contains returns true if the given key exists and is not expired, or false if it does not
+func (c *lru[K, V]) Contains(k K) bool {
+	return c.getEntry(k) != nil
+}
+
+// Put sets the value of the given key and evicts the least recently used entry if the cache is full
+func (c *lru[K, V]) Put(k K, v V) {
+	if e, ok := c.entries[k]; ok {
+		c.setValue(e, v)
+		c.list.moveToFront(e)
+
+		return
+	}
+
+	if c.list.len == c.capacity {
+		e := c.list.back()
+
+		c.removeEntry(e)
+		c.evicted(e, c.expired(e))
+	}
+
+	e := &entry[K, V]{
+		key: k,
+	}
+
+	c.setValue(e, v)
+
+	c.entries[k] = e
+	c.list.pushFront(e)
+}
+
+// Remove removes the given key and returns its value and true, or false if there is no such key or if the key is expired
+func (c *lru[K, V]) Remove(k K) (V, bool) {
+	e := c.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	c.removeEntry(e)
+
+	return e.value, true
+}
+
+// RemoveExpired removes all expired entries and returns their count
+func (c *lru[K, V]) RemoveExpired() int {
+	n := 0
+
+	for e := c.list.back(); e != nil && e != &c.list.root; {
+		p := e.previous
+
+		if c.expired(e) {
+			c.removeEntry(e)
+			c.evicted(e, true)
+
+			n++
+		}
+
+		e = p
+	}
+
+	return n
+}
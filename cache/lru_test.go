@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+func TestLRUOrder(t *testing.T) {
+	c := NewLRUOf[int, int](3)
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	c.Get(1)
+
+	c.Put(4, 4)
+
+	False(t, c.Contains(2))
+
+	// peeking does not mark a key as used
+	c.Peek(3)
+
+	c.Put(5, 5)
+
+	False(t, c.Contains(3))
+
+	// putting marks a key as used
+	c.Put(1, 10)
+
+	c.Put(6, 6)
+
+	False(t, c.Contains(4))
+	True(t, c.Contains(1))
+	True(t, c.Contains(5))
+	True(t, c.Contains(6))
+}
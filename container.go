@@ -1,5 +1,10 @@
 package container
 
+import (
+	"context"
+	"iter"
+)
+
 // Iterator defines a container iterator
 type Iterator interface {
 	// Next iterates to the next element in the container and returns the iterator, or nil if there is no next element
@@ -21,15 +26,26 @@ type Container interface {
 	Empty() bool
 
 	// Chan returns a channel which iterates from the front to the back of the container
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
 	Chan(n int) <-chan interface{}
 	// ChanBack returns a channel which iterates from the back to the front of the container
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
 	ChanBack(n int) <-chan interface{}
+	// ChanContext returns a channel which iterates from the front to the back of the container and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan interface{}
+	// ChanBackContext returns a channel which iterates from the back to the front of the container and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan interface{}
 
 	// Iter returns an iterator which starts at the front of the container, or nil if there are no elements in the container
 	Iter() Iterator
 	// IterBack returns an iterator which starts at the back of the container, or nil if there are no elements in the container
 	IterBack() Iterator
 
+	// All returns a sequence which iterates from the front to the back of the container
+	All() iter.Seq[interface{}]
+	// Backward returns a sequence which iterates from the back to the front of the container
+	Backward() iter.Seq[interface{}]
+
 	// Contains returns true if an element identified by the given id value exists in the container, or false if it does not
 	Contains(id interface{}) bool
 
@@ -0,0 +1,276 @@
+package container
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+	"github.com/zimmski/go-leak"
+)
+
+// V holds the values for basic container tests
+var V = []interface{}{5, 3, 1, 4, 6, 2}
+
+// VLen is the length of V
+var VLen = len(V)
+
+// VMissing holds a value which is not part of V
+var VMissing interface{} = 7
+
+// ContainerTest is the base for all tests of containers
+// Containers do not define how elements are added, so New has to return a container which holds exactly the given values. The order of the elements does not matter, but it has to be the same for every kind of iteration.
+type ContainerTest struct {
+	New func(t *testing.T, vs []interface{}) Container
+}
+
+// Run executes all basic container tests
+func (ct *ContainerTest) Run(t *testing.T) {
+	ct.TestBasic(t)
+	ct.TestIterator(t)
+	ct.TestChannels(t)
+	ct.TestChannelsContext(t)
+	ct.TestSeq(t)
+	ct.TestClear(t)
+}
+
+// sortInts returns the given int values sorted
+func sortInts(vs []interface{}) []int {
+	r := make([]int, len(vs))
+
+	for i, v := range vs {
+		r[i] = v.(int)
+	}
+
+	sort.Ints(r)
+
+	return r
+}
+
+// reverse returns a reversed copy of the given values
+func reverse(vs []interface{}) []interface{} {
+	r := slices.Clone(vs)
+	slices.Reverse(r)
+
+	return r
+}
+
+// TestBasic tests basic container functionality
+func (ct *ContainerTest) TestBasic(t *testing.T) {
+	c := ct.New(t, nil)
+
+	Equal(t, c.Len(), 0)
+	True(t, c.Empty())
+	Equal(t, len(c.Slice()), 0)
+	False(t, c.Contains(V[0]))
+
+	c = ct.New(t, V)
+
+	Equal(t, c.Len(), VLen)
+	False(t, c.Empty())
+	Equal(t, sortInts(c.Slice()), sortInts(V))
+
+	for _, v := range V {
+		True(t, c.Contains(v))
+	}
+
+	False(t, c.Contains(VMissing))
+}
+
+// TestIterator tests iterators
+func (ct *ContainerTest) TestIterator(t *testing.T) {
+	c := ct.New(t, nil)
+
+	Nil(t, c.Iter())
+	Nil(t, c.IterBack())
+
+	c = ct.New(t, V)
+	s := c.Slice()
+
+	var vs []interface{}
+
+	for it := c.Iter(); it != nil; it = it.Next() {
+		vs = append(vs, it.Get())
+	}
+
+	Equal(t, vs, s)
+
+	vs = nil
+
+	for it := c.IterBack(); it != nil; it = it.Previous() {
+		vs = append(vs, it.Get())
+	}
+
+	Equal(t, vs, reverse(s))
+
+	// iterators can change their direction
+	it := c.Iter()
+
+	Equal(t, it.Next().Get(), s[1])
+	Equal(t, it.Previous().Get(), s[0])
+	Nil(t, it.Previous())
+
+	it = c.IterBack()
+
+	Equal(t, it.Previous().Get(), s[VLen-2])
+	Equal(t, it.Next().Get(), s[VLen-1])
+	Nil(t, it.Next())
+}
+
+// TestChannels tests channels
+func (ct *ContainerTest) TestChannels(t *testing.T) {
+	c := ct.New(t, nil)
+
+	for range c.Chan(0) {
+		Fail(t, "empty containers must not send values")
+	}
+	for range c.ChanBack(0) {
+		Fail(t, "empty containers must not send values")
+	}
+
+	c = ct.New(t, V)
+	s := c.Slice()
+
+	for _, n := range []int{0, VLen} {
+		var vs []interface{}
+
+		ch := c.Chan(n)
+		Equal(t, cap(ch), n)
+
+		for v := range ch {
+			vs = append(vs, v)
+		}
+
+		Equal(t, vs, s)
+
+		vs = nil
+
+		ch = c.ChanBack(n)
+		Equal(t, cap(ch), n)
+
+		for v := range ch {
+			vs = append(vs, v)
+		}
+
+		Equal(t, vs, reverse(s))
+	}
+}
+
+// TestChannelsContext tests channels with contexts
+func (ct *ContainerTest) TestChannelsContext(t *testing.T) {
+	c := ct.New(t, V)
+	s := c.Slice()
+
+	// channels with a context which is never done
+	var vs []interface{}
+
+	for v := range c.ChanContext(context.Background(), 0) {
+		vs = append(vs, v)
+	}
+
+	Equal(t, vs, s)
+
+	vs = nil
+
+	for v := range c.ChanBackContext(context.Background(), 0) {
+		vs = append(vs, v)
+	}
+
+	Equal(t, vs, reverse(s))
+
+	// consumers which stop early
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := c.ChanContext(ctx, 0)
+		Equal(t, <-ch, s[0])
+
+		chBack := c.ChanBackContext(ctx, 1)
+		Equal(t, <-chBack, s[VLen-1])
+
+		cancel()
+
+		// wait until the goroutines noticed the cancellation and closed the channels
+		i := 0
+
+		for range ch {
+			i++
+		}
+		for range chBack {
+			i++
+		}
+
+		True(t, i < 2*VLen-3, "goroutines did not stop after the cancellation")
+	}))
+
+	// contexts which are already done
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for range c.ChanContext(ctx, 0) {
+		}
+		for range c.ChanBackContext(ctx, 0) {
+		}
+	}))
+}
+
+// TestSeq tests sequences
+func (ct *ContainerTest) TestSeq(t *testing.T) {
+	c := ct.New(t, nil)
+
+	Equal(t, len(slices.Collect(c.All())), 0)
+	Equal(t, len(slices.Collect(c.Backward())), 0)
+
+	c = ct.New(t, V)
+	s := c.Slice()
+
+	Equal(t, slices.Collect(c.All()), s)
+	Equal(t, slices.Collect(c.Backward()), reverse(s))
+
+	// sequences stop early
+	i := 0
+
+	for v := range c.All() {
+		Equal(t, v, s[i])
+
+		if i == 2 {
+			break
+		}
+
+		i++
+	}
+
+	Equal(t, i, 2)
+
+	i = VLen - 1
+
+	for v := range c.Backward() {
+		Equal(t, v, s[i])
+
+		if i == VLen-3 {
+			break
+		}
+
+		i--
+	}
+
+	Equal(t, i, VLen-3)
+}
+
+// TestClear tests clearing containers
+func (ct *ContainerTest) TestClear(t *testing.T) {
+	c := ct.New(t, V)
+
+	c.Clear()
+
+	Equal(t, c.Len(), 0)
+	True(t, c.Empty())
+	Nil(t, c.Iter())
+	Nil(t, c.IterBack())
+
+	for _, v := range V {
+		False(t, c.Contains(v))
+	}
+}
@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"context"
+	"iter"
+
+	"github.com/zimmski/container"
+)
+
+// Set defines a set holding unique values of type T
+// The values of a set are not ordered, but the order of an unchanged set is stable. The iterators of a set are container iterators, so that a set for values of type interface{} implements container.Container.
+type Set[T any] interface {
+	// Clear resets the set to zero values and resets the set's meta data
+	Clear()
+	// Len returns the current count of set values
+	Len() int
+	// Empty returns true if the current count of set values is zero
+	Empty() bool
+
+	// Chan returns a channel which iterates from the front to the back of the set
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+	Chan(n int) <-chan T
+	// ChanBack returns a channel which iterates from the back to the front of the set
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+	ChanBack(n int) <-chan T
+	// ChanContext returns a channel which iterates from the front to the back of the set and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan T
+	// ChanBackContext returns a channel which iterates from the back to the front of the set and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan T
+
+	// Iter returns an iterator which starts at the front of the set, or nil if there are no values in the set
+	Iter() container.Iterator
+	// IterBack returns an iterator which starts at the back of the set, or nil if there are no values in the set
+	IterBack() container.Iterator
+
+	// All returns a sequence which iterates from the front to the back of the set
+	All() iter.Seq[T]
+	// Backward returns a sequence which iterates from the back to the front of the set
+	Backward() iter.Seq[T]
+
+	// Contains returns true if the value exists in the set, or false if it does not
+	Contains(v T) bool
+
+	// Copy returns an exact copy of the set
+	Copy() Set[T]
+	// Slice returns a copy of the set as a slice
+	Slice() []T
+
+	// Add adds the given value to the set and returns true, or false if the value already exists in the set
+	Add(v T) bool
+	// Remove removes the given value from the set and returns true, or false if the value does not exist in the set
+	Remove(v T) bool
+}
+
+// Map defines a map holding values of type V identified by unique keys of type K
+// The entries of a map are not ordered, but the order of an unchanged map is stable. As a container the elements of a map are its keys. The iterators of a map are container iterators which return the keys, so that a map for keys of type interface{} implements container.Container.
+type Map[K any, V any] interface {
+	// Clear resets the map to zero entries and resets the map's meta data
+	Clear()
+	// Len returns the current count of map entries
+	Len() int
+	// Empty returns true if the current count of map entries is zero
+	Empty() bool
+
+	// Chan returns a channel which iterates over the keys from the front to the back of the map
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+	Chan(n int) <-chan K
+	// ChanBack returns a channel which iterates over the keys from the back to the front of the map
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+	ChanBack(n int) <-chan K
+	// ChanContext returns a channel which iterates over the keys from the front to the back of the map and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan K
+	// ChanBackContext returns a channel which iterates over the keys from the back to the front of the map and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan K
+
+	// Iter returns an iterator over the keys which starts at the front of the map, or nil if there are no entries in the map
+	Iter() container.Iterator
+	// IterBack returns an iterator over the keys which starts at the back of the map, or nil if there are no entries in the map
+	IterBack() container.Iterator
+
+	// All returns a sequence which iterates over the keys from the front to the back of the map
+	All() iter.Seq[K]
+	// Backward returns a sequence which iterates over the keys from the back to the front of the map
+	Backward() iter.Seq[K]
+	// Values returns a sequence which iterates over the values from the front to the back of the map
+	Values() iter.Seq[V]
+	// Entries returns a sequence which iterates over the keys and their values from the front to the back of the map
+	Entries() iter.Seq2[K, V]
+
+	// Contains returns true if the key exists in the map, or false if it does not
+	Contains(k K) bool
+
+	// Copy returns an exact copy of the map
+	Copy() Map[K, V]
+	// Slice returns the keys of the map as a slice
+	Slice() []K
+
+	// Get returns the value of the given key and true, or false if the key does not exist in the map
+	Get(k K) (V, bool)
+	// Put sets the value of the given key and returns the previous value and true, or false if the key did not exist in the map
+	Put(k K, v V) (V, bool)
+	// Remove removes the given key and returns its value and true, or false if the key does not exist in the map
+	Remove(k K) (V, bool)
+}
@@ -0,0 +1,11 @@
+package hash
+
+import (
+	Generic "github.com/zimmski/container/hash/generic"
+)
+
+// Set defines a set
+type Set = Generic.Set[interface{}]
+
+// Map defines a map
+type Map = Generic.Map[interface{}, interface{}]
@@ -0,0 +1,568 @@
+package hashmap
+
+import (
+	"context"
+	"hash/maphash"
+	"iter"
+	"math"
+
+	"github.com/zimmski/container"
+	GenericHash "github.com/zimmski/container/hash/generic"
+)
+
+const (
+	// minCapacity is the smallest number of slots of a table
+	minCapacity = 8
+	// migrateSlots is the number of slots of the old table which are moved by every change of the map while it grows
+	migrateSlots = 4
+)
+
+// entry holds a single slot of a table
+type entry[K any, V any] struct {
+	key   K      // The key of the entry
+	value V      // The value of the entry
+	hash  uint64 // The hash of the key
+	probe int    // The distance of the slot to the home slot of the key plus one, or zero if the slot is empty
+}
+
+// table holds the slots of a Robin Hood hash table, the number of slots is a power of two
+type table[K any, V any] struct {
+	slots []entry[K, V] // The slots of the table
+	len   int           // The current count of entries
+}
+
+// newTable returns a new table with the given number of slots
+func newTable[K any, V any](capacity int) *table[K, V] {
+	return &table[K, V]{
+		slots: make([]entry[K, V], capacity),
+	}
+}
+
+// find returns the slot of the given key, or -1 if the key does not exist in the table
+func (t *table[K, V]) find(h uint64, k K, equal func(a, b K) bool) int {
+	mask := uint64(len(t.slots) - 1)
+	i := h & mask
+
+	for probe := 1; ; probe++ {
+		s := &t.slots[i]
+
+		// the key would have displaced an entry which is closer to its home slot
+		if s.probe < probe {
+			return -1
+		}
+
+		if s.hash == h && equal(s.key, k) {
+			return int(i)
+		}
+
+		i = (i + 1) & mask
+	}
+}
+
+// insert inserts the given entry which key does not exist in the table
+// Entries which are closer to their home slot than the inserted entry are displaced to keep the probe lengths balanced.
+func (t *table[K, V]) insert(e entry[K, V]) {
+	mask := uint64(len(t.slots) - 1)
+	i := e.hash & mask
+
+	e.probe = 1
+
+	for {
+		s := &t.slots[i]
+
+		if s.probe == 0 {
+			*s = e
+
+			break
+		}
+
+		if s.probe < e.probe {
+			*s, e = e, *s
+		}
+
+		e.probe++
+		i = (i + 1) & mask
+	}
+
+	t.len++
+}
+
+// remove removes the entry of the given slot and shifts the following entries back to close the gap
+func (t *table[K, V]) remove(i int) {
+	mask := len(t.slots) - 1
+
+	for {
+		j := (i + 1) & mask
+		n := &t.slots[j]
+
+		if n.probe <= 1 {
+			break
+		}
+
+		t.slots[i] = *n
+		t.slots[i].probe--
+
+		i = j
+	}
+
+	t.slots[i] = entry[K, V]{}
+
+	t.len--
+}
+
+// iterator holds the iterator for a hash map
+type iterator[K any, V any] struct {
+	current int            // The current position in traversal
+	m       *hashMap[K, V] // The map to which this iterator belongs
+}
+
+// Next iterates to the next entry in the map and returns the iterator, or nil if there is no next entry
+func (iter *iterator[K, V]) Next() container.Iterator {
+	iter.current = iter.m.next(iter.current)
+
+	if iter.current == -1 {
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous entry in the map and returns the iterator, or nil if there is no previous entry
+func (iter *iterator[K, V]) Previous() container.Iterator {
+	iter.current = iter.m.previous(iter.current)
+
+	if iter.current == -1 {
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the key of the iterator's current entry
+func (iter *iterator[K, V]) Get() interface{} {
+	return iter.m.slot(iter.current).key
+}
+
+// hashMap holds a hash map which uses open addressing with Robin Hood probing
+// Growing the map is done incrementally. The entries of the old table are moved a few slots at a time with every change of the map, so that no single change has to move all entries. Lookups search both tables until all entries are moved.
+type hashMap[K any, V any] struct {
+	table    *table[K, V] // The table which takes new entries
+	old      *table[K, V] // The table whose entries are moved to the current table, or nil if the map is not growing
+	migrated int          // The slots of the old table before this index are already moved
+
+	capacity int // The number of slots of a new table
+
+	hash  func(k K) uint64  // Hashes keys, equal keys must have equal hashes
+	equal func(a, b K) bool // Compares two keys
+}
+
+// Option defines an option for creating a hash map
+type Option[K any, V any] func(m *hashMap[K, V])
+
+// WithHash sets the function which hashes keys, keys which are equal must have the same hash
+// By default keys are hashed by their dynamic type and value like keys of Go maps, which supports every comparable type. Keys of other types need a hash function.
+func WithHash[K any, V any](hash func(k K) uint64) Option[K, V] {
+	return func(m *hashMap[K, V]) {
+		m.hash = hash
+	}
+}
+
+// WithEqual sets the function which compares keys, keys are compared with == by default
+func WithEqual[K any, V any](equal func(a, b K) bool) Option[K, V] {
+	return func(m *hashMap[K, V]) {
+		m.equal = equal
+	}
+}
+
+// WithCapacity sets the number of entries which fit into the map without growing
+func WithCapacity[K any, V any](c int) Option[K, V] {
+	return func(m *hashMap[K, V]) {
+		m.capacity = capacityFor(c)
+	}
+}
+
+// capacityFor returns the number of slots which hold the given number of entries without growing
+func capacityFor(n int) int {
+	c := minCapacity
+
+	for !fits(n, c) {
+		c *= 2
+	}
+
+	return c
+}
+
+// fits returns true if a table with the given number of slots holds n entries without growing
+func fits(n int, capacity int) bool {
+	return n <= capacity/8*7
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// hashOf returns a function which hashes values by their dynamic type and value with the given seed
+// Values are hashed like keys of Go maps, e.g. pointers by their address and -0 and +0 equally. Values of types which are not comparable make the function panic.
+func hashOf[T any](seed maphash.Seed) func(v T) uint64 {
+	return func(v T) uint64 {
+		return maphash.Comparable[any](seed, v)
+	}
+}
+
+// New returns a new hash map
+func New(opts ...Option[interface{}, interface{}]) *hashMap[interface{}, interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new hash map for keys of type K and values of type V
+func NewOf[K any, V any](opts ...Option[K, V]) *hashMap[K, V] {
+	m := new(hashMap[K, V])
+
+	m.capacity = minCapacity
+	m.hash = hashOf[K](maphash.MakeSeed())
+	m.equal = equal[K]
+
+	for _, o := range opts {
+		o(m)
+	}
+
+	m.Clear()
+
+	return m
+}
+
+// Clear resets the map to zero entries and resets the map's meta data
+func (m *hashMap[K, V]) Clear() {
+	m.table = newTable[K, V](m.capacity)
+	m.old = nil
+	m.migrated = 0
+}
+
+// Len returns the current count of map entries
+func (m *hashMap[K, V]) Len() int {
+	if m.old != nil {
+		return m.table.len + m.old.len
+	}
+
+	return m.table.len
+}
+
+// Empty returns true if the current count of map entries is zero
+func (m *hashMap[K, V]) Empty() bool {
+	return m.Len() == 0
+}
+
+// migrate moves some entries of the old table to the current table
+func (m *hashMap[K, V]) migrate(slots int) {
+	for ; slots > 0 && m.old != nil; slots-- {
+		if m.old.len == 0 {
+			m.old = nil
+			m.migrated = 0
+
+			break
+		}
+
+		s := &m.old.slots[m.migrated]
+
+		if s.probe == 0 {
+			m.migrated++
+
+			continue
+		}
+
+		// removing the entry shifts the following entries back, so the slot is checked again
+		m.table.insert(*s)
+		m.old.remove(m.migrated)
+	}
+}
+
+// grow starts to move all entries to a bigger table if the current table cannot hold another entry
+func (m *hashMap[K, V]) grow() {
+	if fits(m.Len()+1, len(m.table.slots)) {
+		return
+	}
+
+	// the previous growing has to be done before the next can start
+	if m.old != nil {
+		m.migrate(math.MaxInt)
+	}
+
+	m.old = m.table
+	m.table = newTable[K, V](2 * len(m.old.slots))
+	m.migrated = 0
+}
+
+// find returns the table and the slot of the given key, or nil and -1 if the key does not exist in the map
+func (m *hashMap[K, V]) find(h uint64, k K) (*table[K, V], int) {
+	if i := m.table.find(h, k, m.equal); i != -1 {
+		return m.table, i
+	}
+
+	if m.old != nil {
+		if i := m.old.find(h, k, m.equal); i != -1 {
+			return m.old, i
+		}
+	}
+
+	return nil, -1
+}
+
+// slot returns the entry of the given position, the positions of the old table come before the positions of the current table
+func (m *hashMap[K, V]) slot(p int) *entry[K, V] {
+	if m.old != nil {
+		if p < len(m.old.slots) {
+			return &m.old.slots[p]
+		}
+
+		p -= len(m.old.slots)
+	}
+
+	return &m.table.slots[p]
+}
+
+// positions returns the number of positions of the map
+func (m *hashMap[K, V]) positions() int {
+	if m.old != nil {
+		return len(m.old.slots) + len(m.table.slots)
+	}
+
+	return len(m.table.slots)
+}
+
+// next returns the position of the next entry after the given position, or -1 if there is none
+func (m *hashMap[K, V]) next(p int) int {
+	for p++; p < m.positions(); p++ {
+		if m.slot(p).probe != 0 {
+			return p
+		}
+	}
+
+	return -1
+}
+
+// previous returns the position of the previous entry before the given position, or -1 if there is none
+func (m *hashMap[K, V]) previous(p int) int {
+	for p--; p > -1; p-- {
+		if m.slot(p).probe != 0 {
+			return p
+		}
+	}
+
+	return -1
+}
+
+// newIterator returns a new iterator
+func (m *hashMap[K, V]) newIterator(current int) *iterator[K, V] {
+	return &iterator[K, V]{
+		current: current,
+		m:       m,
+	}
+}
+
+// Chan returns a channel which iterates over the keys from the front to the back of the map
+func (m *hashMap[K, V]) Chan(n int) <-chan K {
+	return m.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates over the keys from the back to the front of the map
+func (m *hashMap[K, V]) ChanBack(n int) <-chan K {
+	return m.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates over the keys from the front to the back of the map and which is closed early if the given context is done
+func (m *hashMap[K, V]) ChanContext(ctx context.Context, n int) <-chan K {
+	ch := make(chan K, n)
+
+	go func() {
+		defer close(ch)
+
+		for k := range m.All() {
+			select {
+			case ch <- k:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates over the keys from the back to the front of the map and which is closed early if the given context is done
+func (m *hashMap[K, V]) ChanBackContext(ctx context.Context, n int) <-chan K {
+	ch := make(chan K, n)
+
+	go func() {
+		defer close(ch)
+
+		for k := range m.Backward() {
+			select {
+			case ch <- k:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator over the keys which starts at the front of the map, or nil if there are no entries in the map
+func (m *hashMap[K, V]) Iter() container.Iterator {
+	p := m.next(-1)
+
+	if p == -1 {
+		return nil
+	}
+
+	return m.newIterator(p)
+}
+
+// IterBack returns an iterator over the keys which starts at the back of the map, or nil if there are no entries in the map
+func (m *hashMap[K, V]) IterBack() container.Iterator {
+	p := m.previous(m.positions())
+
+	if p == -1 {
+		return nil
+	}
+
+	return m.newIterator(p)
+}
+
+// All returns a sequence which iterates over the keys from the front to the back of the map
+func (m *hashMap[K, V]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for p := m.next(-1); p != -1; p = m.next(p) {
+			if !yield(m.slot(p).key) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates over the keys from the back to the front of the map
+func (m *hashMap[K, V]) Backward() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for p := m.previous(m.positions()); p != -1; p = m.previous(p) {
+			if !yield(m.slot(p).key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence which iterates over the values from the front to the back of the map
+func (m *hashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for p := m.next(-1); p != -1; p = m.next(p) {
+			if !yield(m.slot(p).value) {
+				return
+			}
+		}
+	}
+}
+
+// Entries returns a sequence which iterates over the keys and their values from the front to the back of the map
+func (m *hashMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := m.next(-1); p != -1; p = m.next(p) {
+			s := m.slot(p)
+
+			if !yield(s.key, s.value) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if the key exists in the map, or false if it does not
+func (m *hashMap[K, V]) Contains(k K) bool {
+	_, i := m.find(m.hash(k), k)
+
+	return i != -1
+}
+
+// Copy returns an exact copy of the map
+func (m *hashMap[K, V]) Copy() GenericHash.Map[K, V] {
+	n := NewOf(WithHash[K, V](m.hash), WithEqual[K, V](m.equal))
+
+	n.capacity = m.capacity
+	n.table = newTable[K, V](capacityFor(max(m.Len(), 1)))
+
+	for k, v := range m.Entries() {
+		n.Put(k, v)
+	}
+
+	return n
+}
+
+// Slice returns the keys of the map as a slice
+func (m *hashMap[K, V]) Slice() []K {
+	s := make([]K, 0, m.Len())
+
+	for k := range m.All() {
+		s = append(s, k)
+	}
+
+	return s
+}
+
+// Get returns the value of the given key and true, or false if the key does not exist in the map
+func (m *hashMap[K, V]) Get(k K) (V, bool) {
+	t, i := m.find(m.hash(k), k)
+
+	if i == -1 {
+		var v V
+
+		return v, false
+	}
+
+	return t.slots[i].value, true
+}
+
+// Put sets the value of the given key and returns the previous value and true, or false if the key did not exist in the map
+func (m *hashMap[K, V]) Put(k K, v V) (V, bool) {
+	m.migrate(migrateSlots)
+
+	h := m.hash(k)
+
+	if t, i := m.find(h, k); i != -1 {
+		p := t.slots[i].value
+
+		t.slots[i].value = v
+
+		return p, true
+	}
+
+	m.grow()
+
+	m.table.insert(entry[K, V]{
+		key:   k,
+		value: v,
+		hash:  h,
+	})
+
+	var p V
+
+	return p, false
+}
+
+// Remove removes the given key and returns its value and true, or false if the key does not exist in the map
+func (m *hashMap[K, V]) Remove(k K) (V, bool) {
+	m.migrate(migrateSlots)
+
+	t, i := m.find(m.hash(k), k)
+
+	if i == -1 {
+		var v V
+
+		return v, false
+	}
+
+	v := t.slots[i].value
+
+	t.remove(i)
+
+	return v, true
+}
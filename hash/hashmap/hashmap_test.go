@@ -0,0 +1,430 @@
+package hashmap
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+)
+
+func checkInvariants[K any, V any](t *testing.T, m *hashMap[K, V]) {
+	tables := []*table[K, V]{m.table}
+
+	if m.old != nil {
+		tables = append(tables, m.old)
+
+		// the already moved slots of the old table are empty
+		for i := 0; i < m.migrated; i++ {
+			Equal(t, m.old.slots[i].probe, 0)
+		}
+	}
+
+	for _, tb := range tables {
+		mask := len(tb.slots) - 1
+		n := 0
+
+		for i, s := range tb.slots {
+			if s.probe == 0 {
+				continue
+			}
+
+			n++
+
+			// the probe length is the distance to the home slot
+			Equal(t, (int(s.hash)+s.probe-1)&mask, i)
+			Equal(t, s.hash, m.hash(s.key))
+
+			// an entry is never further away from its home slot than its predecessor plus one
+			p := tb.slots[(i-1)&mask]
+			True(t, s.probe <= p.probe+1)
+		}
+
+		Equal(t, n, tb.len)
+		True(t, fits(tb.len, len(tb.slots)))
+	}
+}
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New()
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			m := New()
+
+			for _, v := range vs {
+				m.Put(v, v)
+			}
+
+			return m
+		},
+	}
+
+	ct.Run(t)
+}
+
+func TestBasic(t *testing.T) {
+	m := NewOf[string, int]()
+
+	v, ok := m.Get("a")
+	False(t, ok)
+	Equal(t, v, 0)
+
+	v, ok = m.Put("a", 1)
+	False(t, ok)
+	Equal(t, v, 0)
+
+	v, ok = m.Put("b", 2)
+	False(t, ok)
+
+	v, ok = m.Put("a", 3)
+	True(t, ok)
+	Equal(t, v, 1)
+
+	Equal(t, m.Len(), 2)
+
+	v, ok = m.Get("a")
+	True(t, ok)
+	Equal(t, v, 3)
+
+	True(t, m.Contains("b"))
+	False(t, m.Contains("c"))
+
+	keys := m.Slice()
+	slices.Sort(keys)
+	Equal(t, keys, []string{"a", "b"})
+
+	values := slices.Collect(m.Values())
+	slices.Sort(values)
+	Equal(t, values, []int{2, 3})
+
+	for k, v := range m.Entries() {
+		w, ok := m.Get(k)
+		True(t, ok)
+		Equal(t, v, w)
+	}
+
+	// copies are independent
+	c := m.Copy()
+
+	c.Put("c", 4)
+	c.Remove("a")
+
+	Equal(t, m.Len(), 2)
+	True(t, m.Contains("a"))
+	False(t, m.Contains("c"))
+	Equal(t, c.Len(), 2)
+
+	v, ok = m.Remove("a")
+	True(t, ok)
+	Equal(t, v, 3)
+
+	_, ok = m.Remove("a")
+	False(t, ok)
+
+	Equal(t, m.Len(), 1)
+
+	m.Clear()
+
+	True(t, m.Empty())
+	False(t, m.Contains("b"))
+}
+
+func TestGrow(t *testing.T) {
+	m := NewOf[int, int]()
+
+	Equal(t, len(m.table.slots), minCapacity)
+
+	growing := false
+
+	for i := 0; i < 1000; i++ {
+		m.Put(i, -i)
+
+		if m.old != nil {
+			growing = true
+
+			// lookups find the entries of both tables
+			for j := 0; j <= i; j++ {
+				v, ok := m.Get(j)
+				True(t, ok)
+				Equal(t, v, -j)
+			}
+		}
+
+		checkInvariants(t, m)
+	}
+
+	True(t, growing)
+	Equal(t, m.Len(), 1000)
+	Equal(t, len(m.table.slots), 2048)
+
+	// removals move entries too
+	for i := 0; i < 1000; i += 2 {
+		v, ok := m.Remove(i)
+		True(t, ok)
+		Equal(t, v, -i)
+
+		checkInvariants(t, m)
+	}
+
+	Nil(t, m.old)
+	Equal(t, m.Len(), 500)
+
+	for i := 0; i < 1000; i++ {
+		Equal(t, m.Contains(i), i%2 == 1)
+	}
+
+	// the capacity option avoids growing
+	m = NewOf(WithCapacity[int, int](1000))
+
+	c := len(m.table.slots)
+
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i)
+	}
+
+	Nil(t, m.old)
+	Equal(t, len(m.table.slots), c)
+}
+
+func TestCollisions(t *testing.T) {
+	m := NewOf(WithHash[int, int](func(k int) uint64 {
+		return uint64(k % 3)
+	}))
+
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+
+		checkInvariants(t, m)
+	}
+
+	for i := 0; i < 100; i += 3 {
+		m.Remove(i)
+
+		checkInvariants(t, m)
+	}
+
+	for i := 0; i < 100; i++ {
+		v, ok := m.Get(i)
+		Equal(t, ok, i%3 != 0)
+
+		if ok {
+			Equal(t, v, i)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	type key struct {
+		a int
+		b []int
+	}
+
+	m := NewOf(WithHash[key, int](func(k key) uint64 {
+		return uint64(k.a)
+	}), WithEqual[key, int](func(a, b key) bool {
+		return a.a == b.a && slices.Equal(a.b, b.b)
+	}))
+
+	m.Put(key{1, []int{1}}, 1)
+	m.Put(key{1, []int{2}}, 2)
+	m.Put(key{1, []int{1}}, 3)
+
+	Equal(t, m.Len(), 2)
+
+	v, _ := m.Get(key{1, []int{1}})
+	Equal(t, v, 3)
+}
+
+func TestHash(t *testing.T) {
+	type point struct {
+		x, y int
+	}
+
+	m := New()
+
+	m.Put(1, "int")
+	m.Put(int64(1), "int64")
+	m.Put("1", "string")
+	m.Put(point{1, 2}, "point")
+	m.Put(math.Copysign(0, -1), "zero")
+	m.Put(true, "bool")
+	m.Put(nil, "nil")
+
+	Equal(t, m.Len(), 7)
+
+	for k, v := range map[interface{}]interface{}{
+		1:           "int",
+		int64(1):    "int64",
+		"1":         "string",
+		point{1, 2}: "point",
+		0.0:         "zero",
+		true:        "bool",
+		nil:         "nil",
+	} {
+		w, ok := m.Get(k)
+		True(t, ok)
+		Equal(t, w, v)
+	}
+
+	False(t, m.Contains(point{2, 1}))
+	False(t, m.Contains(false))
+}
+
+func TestHashPointers(t *testing.T) {
+	type node struct {
+		value int
+	}
+
+	m := NewOf[*node, int]()
+
+	a := &node{1}
+	b := &node{1}
+
+	m.Put(a, 1)
+	m.Put(b, 2)
+
+	Equal(t, m.Len(), 2)
+
+	// pointers are hashed by their address and not by the value they point to
+	a.value = 2
+
+	v, ok := m.Get(a)
+	True(t, ok)
+	Equal(t, v, 1)
+
+	v, ok = m.Get(b)
+	True(t, ok)
+	Equal(t, v, 2)
+
+	False(t, m.Contains(&node{1}))
+}
+
+func TestHashZeros(t *testing.T) {
+	type point struct {
+		x, y float64
+	}
+
+	f32 := NewOf[float32, int]()
+	f32.Put(float32(math.Copysign(0, -1)), 1)
+	f32.Put(0, 2)
+
+	Equal(t, f32.Len(), 1)
+
+	c := NewOf[complex128, int]()
+	c.Put(complex(math.Copysign(0, -1), math.Copysign(0, -1)), 1)
+	c.Put(0, 2)
+
+	Equal(t, c.Len(), 1)
+
+	p := NewOf[point, int]()
+	p.Put(point{math.Copysign(0, -1), 1}, 1)
+	p.Put(point{0, 1}, 2)
+
+	Equal(t, p.Len(), 1)
+
+	m := New()
+	m.Put(float32(math.Copysign(0, -1)), 1)
+	m.Put(complex64(complex(math.Copysign(0, -1), 0)), 2)
+
+	v, ok := m.Get(float32(0))
+	True(t, ok)
+	Equal(t, v, 1)
+
+	v, ok = m.Get(complex64(0))
+	True(t, ok)
+	Equal(t, v, 2)
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	m := NewOf[int, int]()
+	s := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		k := r.Intn(2000)
+		v := r.Int()
+
+		switch r.Intn(4) {
+		case 0, 1:
+			p, ok := m.Put(k, v)
+
+			q, found := s[k]
+			Equal(t, ok, found)
+			Equal(t, p, q)
+
+			s[k] = v
+		case 2:
+			p, ok := m.Remove(k)
+
+			q, found := s[k]
+			Equal(t, ok, found)
+			Equal(t, p, q)
+
+			delete(s, k)
+		case 3:
+			p, ok := m.Get(k)
+
+			q, found := s[k]
+			Equal(t, ok, found)
+			Equal(t, p, q)
+		}
+
+		Equal(t, m.Len(), len(s))
+
+		if i%500 == 0 {
+			checkInvariants(t, m)
+
+			n := 0
+
+			for k, v := range m.Entries() {
+				Equal(t, v, s[k])
+
+				n++
+			}
+
+			Equal(t, n, len(s))
+		}
+	}
+}
+
+func BenchmarkPut(b *testing.B) {
+	m := NewOf[int, int]()
+
+	for i := 0; i < b.N; i++ {
+		m.Put(i, i)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	m := NewOf[int, int]()
+
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Get(i % 1000)
+	}
+}
+
+func BenchmarkPutRemove(b *testing.B) {
+	m := NewOf[int, int]()
+
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Put(i+1000, i)
+		m.Remove(i)
+	}
+}
@@ -0,0 +1,147 @@
+package hashset
+
+import (
+	"context"
+	"iter"
+
+	"github.com/zimmski/container"
+	GenericHash "github.com/zimmski/container/hash/generic"
+	"github.com/zimmski/container/hash/hashmap"
+)
+
+// set holds a hash set which stores its values as keys of a hash map
+type set[T any] struct {
+	m GenericHash.Map[T, struct{}] // The map holding the values as keys
+
+	opts []hashmap.Option[T, struct{}] // The options of the map for copying the set
+}
+
+// Option defines an option for creating a hash set
+type Option[T any] func(s *set[T])
+
+// WithHash sets the function which hashes values, values which are equal must have the same hash
+// By default values are hashed by their dynamic type and value like keys of Go maps, which supports every comparable type. Values of other types need a hash function.
+func WithHash[T any](hash func(v T) uint64) Option[T] {
+	return func(s *set[T]) {
+		s.opts = append(s.opts, hashmap.WithHash[T, struct{}](hash))
+	}
+}
+
+// WithEqual sets the function which compares values, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(s *set[T]) {
+		s.opts = append(s.opts, hashmap.WithEqual[T, struct{}](equal))
+	}
+}
+
+// WithCapacity sets the number of values which fit into the set without growing
+func WithCapacity[T any](c int) Option[T] {
+	return func(s *set[T]) {
+		s.opts = append(s.opts, hashmap.WithCapacity[T, struct{}](c))
+	}
+}
+
+// New returns a new hash set
+func New(opts ...Option[interface{}]) *set[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new hash set for values of type T
+func NewOf[T any](opts ...Option[T]) *set[T] {
+	s := new(set[T])
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.m = hashmap.NewOf(s.opts...)
+
+	return s
+}
+
+// Clear resets the set to zero values and resets the set's meta data
+func (s *set[T]) Clear() {
+	s.m.Clear()
+}
+
+// Len returns the current count of set values
+func (s *set[T]) Len() int {
+	return s.m.Len()
+}
+
+// Empty returns true if the current count of set values is zero
+func (s *set[T]) Empty() bool {
+	return s.m.Empty()
+}
+
+// Chan returns a channel which iterates from the front to the back of the set
+func (s *set[T]) Chan(n int) <-chan T {
+	return s.m.Chan(n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the set
+func (s *set[T]) ChanBack(n int) <-chan T {
+	return s.m.ChanBack(n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the set and which is closed early if the given context is done
+func (s *set[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	return s.m.ChanContext(ctx, n)
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the set and which is closed early if the given context is done
+func (s *set[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	return s.m.ChanBackContext(ctx, n)
+}
+
+// Iter returns an iterator which starts at the front of the set, or nil if there are no values in the set
+func (s *set[T]) Iter() container.Iterator {
+	return s.m.Iter()
+}
+
+// IterBack returns an iterator which starts at the back of the set, or nil if there are no values in the set
+func (s *set[T]) IterBack() container.Iterator {
+	return s.m.IterBack()
+}
+
+// All returns a sequence which iterates from the front to the back of the set
+func (s *set[T]) All() iter.Seq[T] {
+	return s.m.All()
+}
+
+// Backward returns a sequence which iterates from the back to the front of the set
+func (s *set[T]) Backward() iter.Seq[T] {
+	return s.m.Backward()
+}
+
+// Contains returns true if the value exists in the set, or false if it does not
+func (s *set[T]) Contains(v T) bool {
+	return s.m.Contains(v)
+}
+
+// Copy returns an exact copy of the set
+func (s *set[T]) Copy() GenericHash.Set[T] {
+	return &set[T]{
+		m:    s.m.Copy(),
+		opts: s.opts,
+	}
+}
+
+// Slice returns a copy of the set as a slice
+func (s *set[T]) Slice() []T {
+	return s.m.Slice()
+}
+
+// Add adds the given value to the set and returns true, or false if the value already exists in the set
+func (s *set[T]) Add(v T) bool {
+	_, ok := s.m.Put(v, struct{}{})
+
+	return !ok
+}
+
+// Remove removes the given value from the set and returns true, or false if the value does not exist in the set
+func (s *set[T]) Remove(v T) bool {
+	_, ok := s.m.Remove(v)
+
+	return ok
+}
@@ -0,0 +1,147 @@
+package hashset
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+)
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New()
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			s := New()
+
+			for _, v := range vs {
+				s.Add(v)
+			}
+
+			return s
+		},
+	}
+
+	ct.Run(t)
+}
+
+func TestBasic(t *testing.T) {
+	s := NewOf[int]()
+
+	True(t, s.Empty())
+	False(t, s.Contains(1))
+
+	True(t, s.Add(1))
+	True(t, s.Add(2))
+	False(t, s.Add(1))
+
+	Equal(t, s.Len(), 2)
+	True(t, s.Contains(1))
+	True(t, s.Contains(2))
+
+	vs := s.Slice()
+	slices.Sort(vs)
+	Equal(t, vs, []int{1, 2})
+
+	// copies are independent
+	c := s.Copy()
+
+	c.Add(3)
+	c.Remove(1)
+
+	ws := s.Slice()
+	slices.Sort(ws)
+	Equal(t, ws, vs)
+	True(t, c.Contains(3))
+	False(t, c.Contains(1))
+
+	True(t, s.Remove(1))
+	False(t, s.Remove(1))
+
+	Equal(t, s.Len(), 1)
+
+	s.Clear()
+
+	True(t, s.Empty())
+	False(t, s.Contains(2))
+}
+
+func TestOptions(t *testing.T) {
+	s := NewOf(WithHash(func(v string) uint64 {
+		return uint64(len(v))
+	}), WithEqual(strings.EqualFold), WithCapacity[string](100))
+
+	True(t, s.Add("a"))
+	False(t, s.Add("A"))
+	True(t, s.Add("ab"))
+	False(t, s.Add("AB"))
+
+	Equal(t, s.Len(), 2)
+	True(t, s.Contains("Ab"))
+
+	c := s.Copy()
+
+	True(t, c.Contains("aB"))
+	False(t, c.Add("aB"))
+}
+
+func TestHash(t *testing.T) {
+	type node struct {
+		value int
+	}
+
+	s := NewOf[*node]()
+
+	a := &node{1}
+
+	True(t, s.Add(a))
+	True(t, s.Add(&node{1}))
+
+	// pointers are hashed by their address and not by the value they point to
+	a.value = 2
+
+	True(t, s.Contains(a))
+	False(t, s.Add(a))
+
+	f := NewOf[float32]()
+
+	True(t, f.Add(float32(math.Copysign(0, -1))))
+	False(t, f.Add(0))
+	True(t, f.Contains(0))
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	s := NewOf[int]()
+	m := map[int]struct{}{}
+
+	for i := 0; i < 10000; i++ {
+		v := r.Intn(1000)
+
+		_, found := m[v]
+
+		switch r.Intn(3) {
+		case 0, 1:
+			Equal(t, s.Add(v), !found)
+
+			m[v] = struct{}{}
+		case 2:
+			Equal(t, s.Remove(v), found)
+
+			delete(m, v)
+		}
+
+		Equal(t, s.Len(), len(m))
+	}
+
+	for v := range s.All() {
+		_, ok := m[v]
+		True(t, ok)
+	}
+}
@@ -0,0 +1,484 @@
+package binaryheap
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+// iterator holds a snapshot iterator for a binary heap
+type iterator[T any] struct {
+	snapshot []T // The values of the heap in heap order at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// Next iterates to the next value in the snapshot and returns the iterator, or nil if there is no next value
+func (iter *iterator[T]) Next() container.Iterator {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous value in the snapshot and returns the iterator, or nil if there is no previous value
+func (iter *iterator[T]) Previous() container.Iterator {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current position in the snapshot
+func (iter *iterator[T]) Get() interface{} {
+	return iter.snapshot[iter.i]
+}
+
+// handle holds a single value of the heap
+type handle[T any] struct {
+	heap  *heap[T] // The heap of the handle, or nil if the value was removed
+	index int      // The index of the handle in the heap's array
+	value T        // The value of the handle
+}
+
+// Get returns the current value of the handle
+func (h *handle[T]) Get() T {
+	return h.value
+}
+
+// heap holds a binary heap which is stored in an array
+// The children of the value at index i are at the indices 2i+1 and 2i+2.
+type heap[T any] struct {
+	compare func(a, b T) int // The compare function of the heap
+	items   []*handle[T]     // The values of the heap
+}
+
+// New returns a new binary heap
+func New[T any](compare func(a, b T) int) *heap[T] {
+	return &heap[T]{
+		compare: compare,
+	}
+}
+
+// NewOrdered returns a new binary heap for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *heap[T] {
+	return New(cmp.Compare[T])
+}
+
+// Heapify returns a new binary heap which holds the values of the given slice
+// The heap is built in O(n) which is faster than pushing every value on its own.
+func Heapify[T any](compare func(a, b T) int, s []T) *heap[T] {
+	h := New(compare)
+
+	h.items = make([]*handle[T], len(s))
+
+	for i, v := range s {
+		h.items[i] = &handle[T]{
+			heap:  h,
+			index: i,
+			value: v,
+		}
+	}
+
+	for i := len(h.items)/2 - 1; i > -1; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// HeapifyOrdered returns a new binary heap for ordered values which holds the values of the given slice
+func HeapifyOrdered[T cmp.Ordered](s []T) *heap[T] {
+	return Heapify(cmp.Compare[T], s)
+}
+
+// less returns true if the value at index i has to be in front of the value at index j
+func (h *heap[T]) less(i, j int) bool {
+	return h.compare(h.items[i].value, h.items[j].value) < 0
+}
+
+// swap exchanges the values at the indices i and j
+func (h *heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+// up moves the value at index i up until its parent is not behind it
+func (h *heap[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+
+		if !h.less(i, p) {
+			break
+		}
+
+		h.swap(i, p)
+
+		i = p
+	}
+}
+
+// down moves the value at index i down until none of its children are in front of it and returns true if the value was moved
+func (h *heap[T]) down(i int) bool {
+	s := i
+	n := len(h.items)
+
+	for {
+		c := 2*i + 1
+		if c >= n {
+			break
+		}
+
+		if r := c + 1; r < n && h.less(r, c) {
+			c = r
+		}
+
+		if !h.less(c, i) {
+			break
+		}
+
+		h.swap(i, c)
+
+		i = c
+	}
+
+	return i > s
+}
+
+// fix restores the heap order for the value at index i
+func (h *heap[T]) fix(i int) {
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// own returns the handle of the heap for the given handle, or nil if the handle is not part of the heap
+func (h *heap[T]) own(hd GenericHeap.Handle[T]) *handle[T] {
+	c, ok := hd.(*handle[T])
+	if !ok || c.heap != h {
+		return nil
+	}
+
+	return c
+}
+
+// Clear resets the heap to zero values and resets the heap's meta data
+func (h *heap[T]) Clear() {
+	for _, c := range h.items {
+		c.heap = nil
+		c.index = -1
+	}
+
+	h.items = nil
+}
+
+// Len returns the current value count
+func (h *heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Empty returns true if the current value count is zero
+func (h *heap[T]) Empty() bool {
+	return len(h.items) == 0
+}
+
+// Chan returns a channel which iterates from the front to the back of the heap
+func (h *heap[T]) Chan(n int) <-chan T {
+	return h.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the heap
+func (h *heap[T]) ChanBack(n int) <-chan T {
+	return h.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) Iter() container.Iterator {
+	if len(h.items) == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        0,
+	}
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) IterBack() container.Iterator {
+	if len(h.items) == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        len(h.items) - 1,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the heap
+func (h *heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range h.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the heap
+func (h *heap[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := h.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if a value which compares equal to the given id value exists in the heap, or false if it does not
+func (h *heap[T]) Contains(id T) bool {
+	for _, c := range h.items {
+		if h.compare(id, c.value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Copy returns an exact copy of the heap, handles of the heap are not valid for the copy
+func (h *heap[T]) Copy() GenericHeap.Heap[T] {
+	h2 := New(h.compare)
+
+	h2.items = make([]*handle[T], len(h.items))
+
+	for i, c := range h.items {
+		h2.items[i] = &handle[T]{
+			heap:  h2,
+			index: i,
+			value: c.value,
+		}
+	}
+
+	return h2
+}
+
+// Slice returns a copy of the heap as a slice in heap order
+// The values are sorted which needs O(n log n).
+func (h *heap[T]) Slice() []T {
+	s := make([]T, len(h.items))
+
+	for i, c := range h.items {
+		s[i] = c.value
+	}
+
+	slices.SortFunc(s, h.compare)
+
+	return s
+}
+
+// Peek returns the front value of the heap and true, or false if there is no value
+func (h *heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return h.items[0].value, true
+}
+
+// Push inserts the given value into the heap and returns its handle
+func (h *heap[T]) Push(v T) GenericHeap.Handle[T] {
+	c := &handle[T]{
+		heap:  h,
+		index: len(h.items),
+		value: v,
+	}
+
+	h.items = append(h.items, c)
+
+	h.up(c.index)
+
+	return c
+}
+
+// Pop removes the front value of the heap and returns it and true, or false if there is no value
+func (h *heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	n := len(h.items) - 1
+
+	h.swap(0, n)
+
+	c := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+
+	h.down(0)
+
+	c.heap = nil
+	c.index = -1
+
+	return c.value, true
+}
+
+// Update sets the value of the given handle and restores the heap order and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Update(hd GenericHeap.Handle[T], v T) bool {
+	c := h.own(hd)
+	if c == nil {
+		return false
+	}
+
+	c.value = v
+
+	h.fix(c.index)
+
+	return true
+}
+
+// Fix restores the heap order after the value of the given handle was changed in place and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Fix(hd GenericHeap.Handle[T]) bool {
+	c := h.own(hd)
+	if c == nil {
+		return false
+	}
+
+	h.fix(c.index)
+
+	return true
+}
+
+// DecreaseKey sets the value of the given handle to a value which is not behind its current value and returns true, or false if the handle is not part of the heap or if the value is behind the current value
+func (h *heap[T]) DecreaseKey(hd GenericHeap.Handle[T], v T) bool {
+	c := h.own(hd)
+	if c == nil || h.compare(v, c.value) > 0 {
+		return false
+	}
+
+	c.value = v
+
+	h.up(c.index)
+
+	return true
+}
+
+// Delete removes the value of the given handle and returns it and true, or false if the handle is not part of the heap
+func (h *heap[T]) Delete(hd GenericHeap.Handle[T]) (T, bool) {
+	c := h.own(hd)
+	if c == nil {
+		var v T
+
+		return v, false
+	}
+
+	i := c.index
+	n := len(h.items) - 1
+
+	h.swap(i, n)
+
+	h.items[n] = nil
+	h.items = h.items[:n]
+
+	if i < n {
+		h.fix(i)
+	}
+
+	c.heap = nil
+	c.index = -1
+
+	return c.value, true
+}
+
+// Meld moves all values of the given heap into the heap and clears the given heap
+// Melding two binary heaps needs O(n+m) since the heap order is rebuilt for all values.
+func (h *heap[T]) Meld(h2 GenericHeap.Heap[T]) {
+	o, ok := h2.(*heap[T])
+	if !ok {
+		for !h2.Empty() {
+			v, _ := h2.Pop()
+
+			h.Push(v)
+		}
+
+		return
+	} else if o == h {
+		return
+	}
+
+	for _, c := range o.items {
+		c.heap = h
+		c.index = len(h.items)
+
+		h.items = append(h.items, c)
+	}
+
+	o.items = nil
+
+	for i := len(h.items)/2 - 1; i > -1; i-- {
+		h.down(i)
+	}
+}
@@ -0,0 +1,184 @@
+package binaryheap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	Heap "github.com/zimmski/container/heap"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+// checkInvariants validates the heap order and the indices of all handles
+func checkInvariants[T any](t *testing.T, h *heap[T]) {
+	for i, c := range h.items {
+		Equal(t, c.index, i)
+		Equal(t, c.heap, h)
+
+		if i > 0 {
+			True(t, h.compare(h.items[(i-1)/2].value, c.value) <= 0, "heap order is violated")
+		}
+	}
+}
+
+func TestRunAllTests(t *testing.T) {
+	ht := &GenericHeap.HeapTest{
+		New: func(t *testing.T) GenericHeap.Heap[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	ht.Run(t)
+}
+
+// compareInts compares two int values which are stored as interface{}
+func compareInts(a, b interface{}) int {
+	return cmp.Compare(a.(int), b.(int))
+}
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New(compareInts)
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			return Heapify(compareInts, vs)
+		},
+	}
+
+	ct.Run(t)
+}
+
+func TestInterface(t *testing.T) {
+	var h Heap.Heap = New(func(a, b interface{}) int {
+		return len(a.(string)) - len(b.(string))
+	})
+
+	h.Push("ccc")
+	h.Push("a")
+	h.Push("bb")
+
+	Equal(t, h.Slice(), []interface{}{"a", "bb", "ccc"})
+}
+
+func TestHeapify(t *testing.T) {
+	h := HeapifyOrdered([]int{})
+
+	True(t, h.Empty())
+
+	r := rand.New(rand.NewSource(1))
+
+	for n := 1; n < 100; n++ {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(50)
+		}
+
+		h := HeapifyOrdered(s)
+
+		checkInvariants(t, h)
+
+		Equal(t, h.Len(), n)
+
+		// the heap holds copies of the values
+		s[0] = -1
+
+		False(t, h.Contains(-1))
+
+		p := -1
+
+		for !h.Empty() {
+			v, _ := h.Pop()
+			True(t, p <= v)
+
+			p = v
+		}
+	}
+}
+
+func TestFixPointers(t *testing.T) {
+	type task struct {
+		priority int
+	}
+
+	h := New(func(a, b *task) int {
+		return a.priority - b.priority
+	})
+
+	tasks := make([]*task, 10)
+	handles := make([]GenericHeap.Handle[*task], 10)
+
+	for i := range tasks {
+		tasks[i] = &task{priority: i}
+		handles[i] = h.Push(tasks[i])
+	}
+
+	// change the priorities in place and fix the heap afterwards
+	tasks[9].priority = -1
+	True(t, h.Fix(handles[9]))
+	tasks[0].priority = 20
+	True(t, h.Fix(handles[0]))
+
+	checkInvariants(t, h)
+
+	v, _ := h.Pop()
+	Equal(t, v, tasks[9])
+
+	for i := 1; i < 9; i++ {
+		v, _ := h.Pop()
+		Equal(t, v, tasks[i])
+	}
+
+	v, _ = h.Pop()
+	Equal(t, v, tasks[0])
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	h := NewOrdered[int]()
+	var hs []GenericHeap.Handle[int]
+
+	for i := 0; i < 2000; i++ {
+		switch r.Intn(3) {
+		case 0:
+			hs = append(hs, h.Push(r.Intn(100)))
+		case 1:
+			h.Pop()
+		case 2:
+			if len(hs) > 0 {
+				h.Update(hs[r.Intn(len(hs))], r.Intn(100))
+			}
+		}
+
+		checkInvariants(t, h)
+	}
+}
+
+var heapBenchmark = &GenericHeap.HeapBenchmark{
+	New: func(b *testing.B) GenericHeap.Heap[int] {
+		return NewOrdered[int]()
+	},
+}
+
+func BenchmarkPush(b *testing.B) {
+	heapBenchmark.BenchmarkPush(b)
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	heapBenchmark.BenchmarkPushPop(b)
+}
+
+func BenchmarkDecreaseKey(b *testing.B) {
+	heapBenchmark.BenchmarkDecreaseKey(b)
+}
+
+func BenchmarkDelete(b *testing.B) {
+	heapBenchmark.BenchmarkDelete(b)
+}
+
+func BenchmarkMeld(b *testing.B) {
+	heapBenchmark.BenchmarkMeld(b)
+}
@@ -0,0 +1,581 @@
+package binomialheap
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+// iterator holds a snapshot iterator for a binomial heap
+type iterator[T any] struct {
+	snapshot []T // The values of the heap in heap order at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// Next iterates to the next value in the snapshot and returns the iterator, or nil if there is no next value
+func (iter *iterator[T]) Next() container.Iterator {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous value in the snapshot and returns the iterator, or nil if there is no previous value
+func (iter *iterator[T]) Previous() container.Iterator {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current position in the snapshot
+func (iter *iterator[T]) Get() interface{} {
+	return iter.snapshot[iter.i]
+}
+
+// owner identifies the heap of a node
+// Owners form a union-find structure so that melding a heap hands over all its nodes in O(1).
+type owner struct {
+	parent *owner // The owner this owner was melded into, or nil if it still identifies a heap
+}
+
+// find returns the owner which currently identifies the heap while compressing the path
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+
+		o = o.parent
+	}
+
+	return o
+}
+
+// item holds a single value of a binomial heap
+// Values move between nodes while the heap order is restored, items keep the handles stable.
+type item[T any] struct {
+	owner *owner   // The owner of the item, or nil if the item was removed
+	node  *node[T] // The node which currently holds the item
+	value T        // The value of the item
+}
+
+// Get returns the current value of the item
+func (it *item[T]) Get() T {
+	return it.value
+}
+
+// node holds a single node of a binomial tree
+type node[T any] struct {
+	item    *item[T] // The item of the node
+	parent  *node[T] // The parent of the node
+	child   *node[T] // The first child of the node which has the highest degree of all children
+	sibling *node[T] // The next sibling of the node, for roots the next root with a higher degree
+	degree  int      // The count of children of the node
+}
+
+// heap holds a binomial heap which is a list of binomial trees with distinct degrees
+// Push, Pop, Meld, DecreaseKey and Delete need O(log n).
+type heap[T any] struct {
+	compare func(a, b T) int // The compare function of the heap
+	owner   *owner           // The owner of the heap's items
+	head    *node[T]         // The root with the lowest degree
+	min     *node[T]         // The root which holds the front value
+	len     int              // The current value count
+}
+
+// New returns a new binomial heap
+func New[T any](compare func(a, b T) int) *heap[T] {
+	h := new(heap[T])
+
+	h.compare = compare
+
+	h.Clear()
+
+	return h
+}
+
+// NewOrdered returns a new binomial heap for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *heap[T] {
+	return New(cmp.Compare[T])
+}
+
+// less returns true if the value of node a has to be in front of the value of node b
+func (h *heap[T]) less(a, b *node[T]) bool {
+	return h.compare(a.item.value, b.item.value) < 0
+}
+
+// mergeRoots merges two root lists into one root list which is ordered by degree
+func mergeRoots[T any](a, b *node[T]) *node[T] {
+	var head *node[T]
+	var last *node[T]
+
+	for a != nil || b != nil {
+		var c *node[T]
+
+		if b == nil || (a != nil && a.degree <= b.degree) {
+			c, a = a, a.sibling
+		} else {
+			c, b = b, b.sibling
+		}
+
+		if last == nil {
+			head = c
+		} else {
+			last.sibling = c
+		}
+
+		last = c
+	}
+
+	return head
+}
+
+// linkTree makes the root y the first child of the root z which have the same degree
+func linkTree[T any](y, z *node[T]) {
+	y.parent = z
+	y.sibling = z.child
+
+	z.child = y
+	z.degree++
+}
+
+// union merges the given root list into the heap and links all trees with the same degree
+func (h *heap[T]) union(b *node[T]) {
+	h.head = mergeRoots(h.head, b)
+
+	if h.head != nil {
+		var prev *node[T]
+
+		x := h.head
+		next := x.sibling
+
+		for next != nil {
+			if x.degree != next.degree || (next.sibling != nil && next.sibling.degree == x.degree) {
+				prev = x
+				x = next
+			} else if !h.less(next, x) {
+				x.sibling = next.sibling
+
+				linkTree(next, x)
+			} else {
+				if prev == nil {
+					h.head = next
+				} else {
+					prev.sibling = next
+				}
+
+				linkTree(x, next)
+
+				x = next
+			}
+
+			next = x.sibling
+		}
+	}
+
+	h.updateMin()
+}
+
+// updateMin searches the root which holds the front value
+func (h *heap[T]) updateMin() {
+	h.min = nil
+
+	for c := h.head; c != nil; c = c.sibling {
+		if h.min == nil || h.less(c, h.min) {
+			h.min = c
+		}
+	}
+}
+
+// up moves the item of the given node up until its parent is not behind it, or up to the root if force is set, and returns the item's new node
+func (h *heap[T]) up(n *node[T], force bool) *node[T] {
+	for n.parent != nil && (force || h.less(n, n.parent)) {
+		p := n.parent
+
+		n.item, p.item = p.item, n.item
+		n.item.node = n
+		p.item.node = p
+
+		n = p
+	}
+
+	return n
+}
+
+// removeRoot removes the given root from the root list and adds its children as new roots
+func (h *heap[T]) removeRoot(r *node[T]) {
+	if h.head == r {
+		h.head = r.sibling
+	} else {
+		p := h.head
+		for p.sibling != r {
+			p = p.sibling
+		}
+
+		p.sibling = r.sibling
+	}
+
+	// the children are ordered by decreasing degree and have to be reversed to become a root list
+	var children *node[T]
+
+	for c := r.child; c != nil; {
+		next := c.sibling
+
+		c.parent = nil
+		c.sibling = children
+
+		children = c
+		c = next
+	}
+
+	h.union(children)
+}
+
+// remove removes the given item from the heap
+func (h *heap[T]) remove(it *item[T]) {
+	n := h.up(it.node, true)
+
+	h.removeRoot(n)
+
+	it.owner = nil
+	it.node = nil
+
+	h.len--
+}
+
+// insert inserts the given removed item into the heap
+func (h *heap[T]) insert(it *item[T]) {
+	it.owner = h.owner
+	it.node = &node[T]{
+		item: it,
+	}
+
+	h.union(it.node)
+
+	h.len++
+}
+
+// own returns the item of the heap for the given handle, or nil if the handle is not part of the heap
+func (h *heap[T]) own(hd GenericHeap.Handle[T]) *item[T] {
+	it, ok := hd.(*item[T])
+	if !ok || it.owner == nil || it.owner.find() != h.owner {
+		return nil
+	}
+
+	return it
+}
+
+// walk calls the given function for every value of the heap in no specific order
+func (h *heap[T]) walk(f func(v T)) {
+	if h.head == nil {
+		return
+	}
+
+	stack := []*node[T]{h.head}
+
+	for len(stack) != 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for ; n != nil; n = n.sibling {
+			f(n.item.value)
+
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+		}
+	}
+}
+
+// Clear resets the heap to zero values and resets the heap's meta data
+func (h *heap[T]) Clear() {
+	h.owner = &owner{}
+	h.head = nil
+	h.min = nil
+	h.len = 0
+}
+
+// Len returns the current value count
+func (h *heap[T]) Len() int {
+	return h.len
+}
+
+// Empty returns true if the current value count is zero
+func (h *heap[T]) Empty() bool {
+	return h.len == 0
+}
+
+// Chan returns a channel which iterates from the front to the back of the heap
+func (h *heap[T]) Chan(n int) <-chan T {
+	return h.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the heap
+func (h *heap[T]) ChanBack(n int) <-chan T {
+	return h.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) Iter() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        0,
+	}
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) IterBack() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        h.len - 1,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the heap
+func (h *heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range h.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the heap
+func (h *heap[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := h.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if a value which compares equal to the given id value exists in the heap, or false if it does not
+func (h *heap[T]) Contains(id T) bool {
+	found := false
+
+	h.walk(func(v T) {
+		if !found && h.compare(id, v) == 0 {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// Copy returns an exact copy of the heap, handles of the heap are not valid for the copy
+func (h *heap[T]) Copy() GenericHeap.Heap[T] {
+	h2 := New(h.compare)
+
+	h.walk(func(v T) {
+		h2.Push(v)
+	})
+
+	return h2
+}
+
+// Slice returns a copy of the heap as a slice in heap order
+// The values are sorted which needs O(n log n).
+func (h *heap[T]) Slice() []T {
+	s := make([]T, 0, h.len)
+
+	h.walk(func(v T) {
+		s = append(s, v)
+	})
+
+	slices.SortFunc(s, h.compare)
+
+	return s
+}
+
+// Peek returns the front value of the heap and true, or false if there is no value
+func (h *heap[T]) Peek() (T, bool) {
+	if h.min == nil {
+		var v T
+
+		return v, false
+	}
+
+	return h.min.item.value, true
+}
+
+// Push inserts the given value into the heap and returns its handle
+func (h *heap[T]) Push(v T) GenericHeap.Handle[T] {
+	it := &item[T]{
+		value: v,
+	}
+
+	h.insert(it)
+
+	return it
+}
+
+// Pop removes the front value of the heap and returns it and true, or false if there is no value
+func (h *heap[T]) Pop() (T, bool) {
+	if h.min == nil {
+		var v T
+
+		return v, false
+	}
+
+	it := h.min.item
+
+	h.remove(it)
+
+	return it.value, true
+}
+
+// Update sets the value of the given handle and restores the heap order and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Update(hd GenericHeap.Handle[T], v T) bool {
+	it := h.own(hd)
+	if it == nil {
+		return false
+	}
+
+	if h.compare(v, it.value) <= 0 {
+		return h.DecreaseKey(it, v)
+	}
+
+	h.remove(it)
+
+	it.value = v
+
+	h.insert(it)
+
+	return true
+}
+
+// Fix restores the heap order after the value of the given handle was changed in place and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Fix(hd GenericHeap.Handle[T]) bool {
+	it := h.own(hd)
+	if it == nil {
+		return false
+	}
+
+	h.remove(it)
+	h.insert(it)
+
+	return true
+}
+
+// DecreaseKey sets the value of the given handle to a value which is not behind its current value and returns true, or false if the handle is not part of the heap or if the value is behind the current value
+func (h *heap[T]) DecreaseKey(hd GenericHeap.Handle[T], v T) bool {
+	it := h.own(hd)
+	if it == nil || h.compare(v, it.value) > 0 {
+		return false
+	}
+
+	it.value = v
+
+	if n := h.up(it.node, false); n.parent == nil && h.less(n, h.min) {
+		h.min = n
+	}
+
+	return true
+}
+
+// Delete removes the value of the given handle and returns it and true, or false if the handle is not part of the heap
+func (h *heap[T]) Delete(hd GenericHeap.Handle[T]) (T, bool) {
+	it := h.own(hd)
+	if it == nil {
+		var v T
+
+		return v, false
+	}
+
+	h.remove(it)
+
+	return it.value, true
+}
+
+// Meld moves all values of the given heap into the heap and clears the given heap
+// Melding two binomial heaps needs O(log n).
+func (h *heap[T]) Meld(h2 GenericHeap.Heap[T]) {
+	o, ok := h2.(*heap[T])
+	if !ok {
+		for !h2.Empty() {
+			v, _ := h2.Pop()
+
+			h.Push(v)
+		}
+
+		return
+	} else if o == h {
+		return
+	}
+
+	h.union(o.head)
+	h.len += o.len
+
+	o.owner.parent = h.owner
+
+	o.Clear()
+}
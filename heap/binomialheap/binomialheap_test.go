@@ -0,0 +1,146 @@
+package binomialheap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+func TestRunAllTests(t *testing.T) {
+	ht := &GenericHeap.HeapTest{
+		New: func(t *testing.T) GenericHeap.Heap[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	ht.Run(t)
+}
+
+// compareInts compares two int values which are stored as interface{}
+func compareInts(a, b interface{}) int {
+	return cmp.Compare(a.(int), b.(int))
+}
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New(compareInts)
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			h := New(compareInts)
+
+			for _, v := range vs {
+				h.Push(v)
+			}
+
+			return h
+		},
+	}
+
+	ct.Run(t)
+}
+
+// checkInvariants validates the binomial trees, the items, the heap order, the front value and the length of the heap
+func checkInvariants[T any](t *testing.T, h *heap[T]) {
+	count := 0
+
+	var check func(n *node[T]) int
+	check = func(n *node[T]) int {
+		Equal(t, n.item.node, n)
+		Equal(t, n.item.owner.find(), h.owner)
+
+		size := 1
+		degree := 0
+
+		// the children have the degrees n.degree-1 down to 0
+		for c := n.child; c != nil; c = c.sibling {
+			Equal(t, c.parent, n)
+			Equal(t, c.degree, n.degree-1-degree)
+			True(t, h.compare(n.item.value, c.item.value) <= 0, "heap order is violated")
+
+			size += check(c)
+			degree++
+		}
+
+		Equal(t, degree, n.degree)
+		Equal(t, size, 1<<n.degree)
+
+		return size
+	}
+
+	d := -1
+
+	for c := h.head; c != nil; c = c.sibling {
+		Nil(t, c.parent)
+		True(t, c.degree > d, "root degrees are not increasing")
+		True(t, h.compare(h.min.item.value, c.item.value) <= 0, "front value is wrong")
+
+		d = c.degree
+		count += check(c)
+	}
+
+	Equal(t, count, h.len)
+	Equal(t, h.min == nil, h.len == 0)
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	h := NewOrdered[int]()
+	var hs []GenericHeap.Handle[int]
+
+	for i := 0; i < 3000; i++ {
+		switch r.Intn(6) {
+		case 0, 1:
+			hs = append(hs, h.Push(r.Intn(100)))
+		case 2:
+			h.Pop()
+		case 3:
+			if len(hs) > 0 {
+				h.Update(hs[r.Intn(len(hs))], r.Intn(100))
+			}
+		case 4:
+			if len(hs) > 0 {
+				c := hs[r.Intn(len(hs))]
+
+				h.DecreaseKey(c, c.Get()-r.Intn(20))
+			}
+		case 5:
+			if len(hs) > 0 {
+				h.Delete(hs[r.Intn(len(hs))])
+			}
+		}
+
+		checkInvariants(t, h)
+	}
+}
+
+var heapBenchmark = &GenericHeap.HeapBenchmark{
+	New: func(b *testing.B) GenericHeap.Heap[int] {
+		return NewOrdered[int]()
+	},
+}
+
+func BenchmarkPush(b *testing.B) {
+	heapBenchmark.BenchmarkPush(b)
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	heapBenchmark.BenchmarkPushPop(b)
+}
+
+func BenchmarkDecreaseKey(b *testing.B) {
+	heapBenchmark.BenchmarkDecreaseKey(b)
+}
+
+func BenchmarkDelete(b *testing.B) {
+	heapBenchmark.BenchmarkDelete(b)
+}
+
+func BenchmarkMeld(b *testing.B) {
+	heapBenchmark.BenchmarkMeld(b)
+}
@@ -0,0 +1,644 @@
+package fibonacciheap
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+// iterator holds a snapshot iterator for a Fibonacci heap
+type iterator[T any] struct {
+	snapshot []T // The values of the heap in heap order at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// Next iterates to the next value in the snapshot and returns the iterator, or nil if there is no next value
+func (iter *iterator[T]) Next() container.Iterator {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous value in the snapshot and returns the iterator, or nil if there is no previous value
+func (iter *iterator[T]) Previous() container.Iterator {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current position in the snapshot
+func (iter *iterator[T]) Get() interface{} {
+	return iter.snapshot[iter.i]
+}
+
+// owner identifies the heap of a node
+// Owners form a union-find structure so that melding a heap hands over all its nodes in O(1).
+type owner struct {
+	parent *owner // The owner this owner was melded into, or nil if it still identifies a heap
+}
+
+// find returns the owner which currently identifies the heap while compressing the path
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+
+		o = o.parent
+	}
+
+	return o
+}
+
+// node holds a single node of a Fibonacci heap
+type node[T any] struct {
+	owner  *owner   // The owner of the node, or nil if the node was removed
+	parent *node[T] // The parent of the node
+	child  *node[T] // Any child of the node
+	left   *node[T] // The previous node in the circular list of siblings
+	right  *node[T] // The next node in the circular list of siblings
+	degree int      // The count of children of the node
+	mark   bool     // Marks if the node lost a child since it became the child of its parent
+	value  T        // The value of the node
+}
+
+// Get returns the current value of the node
+func (n *node[T]) Get() T {
+	return n.value
+}
+
+// heap holds a Fibonacci heap which is a circular list of heap ordered trees
+// Push, Meld and DecreaseKey need amortized O(1), Pop and Delete need amortized O(log n).
+type heap[T any] struct {
+	compare func(a, b T) int // The compare function of the heap
+	owner   *owner           // The owner of the heap's nodes
+	min     *node[T]         // The root which holds the front value
+	len     int              // The current value count
+}
+
+// New returns a new Fibonacci heap
+func New[T any](compare func(a, b T) int) *heap[T] {
+	h := new(heap[T])
+
+	h.compare = compare
+
+	h.Clear()
+
+	return h
+}
+
+// NewOrdered returns a new Fibonacci heap for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *heap[T] {
+	return New(cmp.Compare[T])
+}
+
+// less returns true if the value of node a has to be in front of the value of node b
+func (h *heap[T]) less(a, b *node[T]) bool {
+	return h.compare(a.value, b.value) < 0
+}
+
+// splice inserts the detached node n into the circular list right of the node l
+func splice[T any](l, n *node[T]) {
+	n.left = l
+	n.right = l.right
+
+	l.right.left = n
+	l.right = n
+}
+
+// unlink removes the node n from its circular list
+func unlink[T any](n *node[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+
+	n.left = n
+	n.right = n
+}
+
+// addRoot adds the detached node n to the root list
+func (h *heap[T]) addRoot(n *node[T]) {
+	n.parent = nil
+	n.mark = false
+
+	if h.min == nil {
+		n.left = n
+		n.right = n
+
+		h.min = n
+	} else {
+		splice(h.min, n)
+
+		if h.less(n, h.min) {
+			h.min = n
+		}
+	}
+}
+
+// link makes the root y a child of the root x
+func (h *heap[T]) link(y, x *node[T]) {
+	unlink(y)
+
+	y.parent = x
+	y.mark = false
+
+	if x.child == nil {
+		x.child = y
+	} else {
+		splice(x.child, y)
+	}
+
+	x.degree++
+}
+
+// consolidate links the roots until every root has a distinct degree and searches the new front value
+func (h *heap[T]) consolidate() {
+	var roots []*node[T]
+
+	c := h.min
+	for {
+		roots = append(roots, c)
+
+		c = c.right
+		if c == h.min {
+			break
+		}
+	}
+
+	var degrees []*node[T]
+
+	for _, x := range roots {
+		d := x.degree
+
+		for d < len(degrees) && degrees[d] != nil {
+			y := degrees[d]
+			if h.less(y, x) {
+				x, y = y, x
+			}
+
+			h.link(y, x)
+
+			degrees[d] = nil
+			d++
+		}
+
+		for len(degrees) <= d {
+			degrees = append(degrees, nil)
+		}
+
+		degrees[d] = x
+	}
+
+	h.min = nil
+
+	for _, x := range degrees {
+		if x != nil && (h.min == nil || h.less(x, h.min)) {
+			h.min = x
+		}
+	}
+}
+
+// removeMin removes the root which holds the front value
+func (h *heap[T]) removeMin() *node[T] {
+	z := h.min
+
+	for z.child != nil {
+		c := z.child
+
+		if c.right == c {
+			z.child = nil
+		} else {
+			z.child = c.right
+
+			unlink(c)
+		}
+
+		c.parent = nil
+		c.mark = false
+
+		splice(z, c)
+	}
+
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+
+		unlink(z)
+
+		h.consolidate()
+	}
+
+	z.owner = nil
+	z.degree = 0
+
+	h.len--
+
+	return z
+}
+
+// cut moves the node n from the children of its parent p to the root list
+func (h *heap[T]) cut(n, p *node[T]) {
+	if n.right == n {
+		p.child = nil
+	} else {
+		if p.child == n {
+			p.child = n.right
+		}
+
+		unlink(n)
+	}
+
+	p.degree--
+
+	h.addRoot(n)
+}
+
+// cascadingCut cuts the given node and its ancestors as long as they already lost a child
+func (h *heap[T]) cascadingCut(p *node[T]) {
+	for p.parent != nil {
+		if !p.mark {
+			p.mark = true
+
+			return
+		}
+
+		pp := p.parent
+
+		h.cut(p, pp)
+
+		p = pp
+	}
+}
+
+// remove removes the given node from the heap
+func (h *heap[T]) remove(n *node[T]) {
+	if p := n.parent; p != nil {
+		h.cut(n, p)
+		h.cascadingCut(p)
+	}
+
+	h.min = n
+
+	h.removeMin()
+}
+
+// insert inserts the given detached node into the heap
+func (h *heap[T]) insert(n *node[T]) {
+	n.owner = h.owner
+
+	h.addRoot(n)
+
+	h.len++
+}
+
+// own returns the node of the heap for the given handle, or nil if the handle is not part of the heap
+func (h *heap[T]) own(hd GenericHeap.Handle[T]) *node[T] {
+	n, ok := hd.(*node[T])
+	if !ok || n.owner == nil || n.owner.find() != h.owner {
+		return nil
+	}
+
+	return n
+}
+
+// walk calls the given function for every value of the heap in no specific order
+func (h *heap[T]) walk(f func(v T)) {
+	if h.min == nil {
+		return
+	}
+
+	stack := []*node[T]{h.min}
+
+	for len(stack) != 0 {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n := first
+		for {
+			f(n.value)
+
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+
+			n = n.right
+			if n == first {
+				break
+			}
+		}
+	}
+}
+
+// Clear resets the heap to zero values and resets the heap's meta data
+func (h *heap[T]) Clear() {
+	h.owner = &owner{}
+	h.min = nil
+	h.len = 0
+}
+
+// Len returns the current value count
+func (h *heap[T]) Len() int {
+	return h.len
+}
+
+// Empty returns true if the current value count is zero
+func (h *heap[T]) Empty() bool {
+	return h.len == 0
+}
+
+// Chan returns a channel which iterates from the front to the back of the heap
+func (h *heap[T]) Chan(n int) <-chan T {
+	return h.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the heap
+func (h *heap[T]) ChanBack(n int) <-chan T {
+	return h.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) Iter() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        0,
+	}
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) IterBack() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        h.len - 1,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the heap
+func (h *heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range h.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the heap
+func (h *heap[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := h.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if a value which compares equal to the given id value exists in the heap, or false if it does not
+func (h *heap[T]) Contains(id T) bool {
+	found := false
+
+	h.walk(func(v T) {
+		if !found && h.compare(id, v) == 0 {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// Copy returns an exact copy of the heap, handles of the heap are not valid for the copy
+func (h *heap[T]) Copy() GenericHeap.Heap[T] {
+	h2 := New(h.compare)
+
+	h.walk(func(v T) {
+		h2.Push(v)
+	})
+
+	return h2
+}
+
+// Slice returns a copy of the heap as a slice in heap order
+// The values are sorted which needs O(n log n).
+func (h *heap[T]) Slice() []T {
+	s := make([]T, 0, h.len)
+
+	h.walk(func(v T) {
+		s = append(s, v)
+	})
+
+	slices.SortFunc(s, h.compare)
+
+	return s
+}
+
+// Peek returns the front value of the heap and true, or false if there is no value
+func (h *heap[T]) Peek() (T, bool) {
+	if h.min == nil {
+		var v T
+
+		return v, false
+	}
+
+	return h.min.value, true
+}
+
+// Push inserts the given value into the heap and returns its handle
+func (h *heap[T]) Push(v T) GenericHeap.Handle[T] {
+	n := &node[T]{
+		value: v,
+	}
+
+	h.insert(n)
+
+	return n
+}
+
+// Pop removes the front value of the heap and returns it and true, or false if there is no value
+func (h *heap[T]) Pop() (T, bool) {
+	if h.min == nil {
+		var v T
+
+		return v, false
+	}
+
+	n := h.removeMin()
+
+	return n.value, true
+}
+
+// Update sets the value of the given handle and restores the heap order and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Update(hd GenericHeap.Handle[T], v T) bool {
+	n := h.own(hd)
+	if n == nil {
+		return false
+	}
+
+	if h.compare(v, n.value) <= 0 {
+		return h.DecreaseKey(n, v)
+	}
+
+	h.remove(n)
+
+	n.value = v
+
+	h.insert(n)
+
+	return true
+}
+
+// Fix restores the heap order after the value of the given handle was changed in place and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Fix(hd GenericHeap.Handle[T]) bool {
+	n := h.own(hd)
+	if n == nil {
+		return false
+	}
+
+	h.remove(n)
+	h.insert(n)
+
+	return true
+}
+
+// DecreaseKey sets the value of the given handle to a value which is not behind its current value and returns true, or false if the handle is not part of the heap or if the value is behind the current value
+func (h *heap[T]) DecreaseKey(hd GenericHeap.Handle[T], v T) bool {
+	n := h.own(hd)
+	if n == nil || h.compare(v, n.value) > 0 {
+		return false
+	}
+
+	n.value = v
+
+	if p := n.parent; p != nil && h.less(n, p) {
+		h.cut(n, p)
+		h.cascadingCut(p)
+	}
+
+	if h.less(n, h.min) {
+		h.min = n
+	}
+
+	return true
+}
+
+// Delete removes the value of the given handle and returns it and true, or false if the handle is not part of the heap
+func (h *heap[T]) Delete(hd GenericHeap.Handle[T]) (T, bool) {
+	n := h.own(hd)
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	h.remove(n)
+
+	return n.value, true
+}
+
+// Meld moves all values of the given heap into the heap and clears the given heap
+// Melding two Fibonacci heaps needs O(1).
+func (h *heap[T]) Meld(h2 GenericHeap.Heap[T]) {
+	o, ok := h2.(*heap[T])
+	if !ok {
+		for !h2.Empty() {
+			v, _ := h2.Pop()
+
+			h.Push(v)
+		}
+
+		return
+	} else if o == h {
+		return
+	}
+
+	if o.min != nil {
+		if h.min == nil {
+			h.min = o.min
+		} else {
+			// concatenate both circular root lists
+			a, b := h.min, o.min
+			ar, bl := a.right, b.left
+
+			a.right = b
+			b.left = a
+			bl.right = ar
+			ar.left = bl
+
+			if h.less(b, a) {
+				h.min = b
+			}
+		}
+	}
+
+	h.len += o.len
+
+	o.owner.parent = h.owner
+
+	o.Clear()
+}
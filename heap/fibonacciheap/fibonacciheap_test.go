@@ -0,0 +1,173 @@
+package fibonacciheap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	"github.com/zimmski/container/heap/binaryheap"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+func TestRunAllTests(t *testing.T) {
+	ht := &GenericHeap.HeapTest{
+		New: func(t *testing.T) GenericHeap.Heap[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	ht.Run(t)
+}
+
+// compareInts compares two int values which are stored as interface{}
+func compareInts(a, b interface{}) int {
+	return cmp.Compare(a.(int), b.(int))
+}
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New(compareInts)
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			h := New(compareInts)
+
+			for _, v := range vs {
+				h.Push(v)
+			}
+
+			return h
+		},
+	}
+
+	ct.Run(t)
+}
+
+// checkInvariants validates the circular lists, the owners, the heap order, the front value and the length of the heap
+func checkInvariants[T any](t *testing.T, h *heap[T]) {
+	if h.min == nil {
+		Equal(t, h.len, 0)
+
+		return
+	}
+
+	count := 0
+
+	var check func(first *node[T], parent *node[T]) int
+	check = func(first *node[T], parent *node[T]) int {
+		siblings := 0
+
+		n := first
+		for {
+			count++
+			siblings++
+
+			Equal(t, n.right.left, n)
+			Equal(t, n.left.right, n)
+			Equal(t, n.parent, parent)
+			Equal(t, n.owner.find(), h.owner)
+
+			if parent == nil {
+				True(t, h.compare(h.min.value, n.value) <= 0, "front value is wrong")
+			} else {
+				True(t, h.compare(parent.value, n.value) <= 0, "heap order is violated")
+			}
+
+			if n.child != nil {
+				Equal(t, check(n.child, n), n.degree)
+			} else {
+				Equal(t, n.degree, 0)
+			}
+
+			n = n.right
+			if n == first {
+				break
+			}
+		}
+
+		return siblings
+	}
+
+	check(h.min, nil)
+
+	Equal(t, count, h.len)
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	h := NewOrdered[int]()
+	var hs []GenericHeap.Handle[int]
+
+	for i := 0; i < 3000; i++ {
+		switch r.Intn(6) {
+		case 0, 1:
+			hs = append(hs, h.Push(r.Intn(100)))
+		case 2:
+			h.Pop()
+		case 3:
+			if len(hs) > 0 {
+				h.Update(hs[r.Intn(len(hs))], r.Intn(100))
+			}
+		case 4:
+			if len(hs) > 0 {
+				c := hs[r.Intn(len(hs))]
+
+				h.DecreaseKey(c, c.Get()-r.Intn(20))
+			}
+		case 5:
+			if len(hs) > 0 {
+				h.Delete(hs[r.Intn(len(hs))])
+			}
+		}
+
+		checkInvariants(t, h)
+	}
+}
+
+func TestMeldOtherImplementation(t *testing.T) {
+	h := NewOrdered[int]()
+	h.Push(2)
+
+	o := binaryheap.NewOrdered[int]()
+	oh := o.Push(1)
+	o.Push(3)
+
+	h.Meld(o)
+
+	checkInvariants(t, h)
+
+	True(t, o.Empty())
+	Equal(t, h.Slice(), []int{1, 2, 3})
+
+	// handles of other implementations are not valid for the heap
+	False(t, h.Update(oh, 0))
+}
+
+var heapBenchmark = &GenericHeap.HeapBenchmark{
+	New: func(b *testing.B) GenericHeap.Heap[int] {
+		return NewOrdered[int]()
+	},
+}
+
+func BenchmarkPush(b *testing.B) {
+	heapBenchmark.BenchmarkPush(b)
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	heapBenchmark.BenchmarkPushPop(b)
+}
+
+func BenchmarkDecreaseKey(b *testing.B) {
+	heapBenchmark.BenchmarkDecreaseKey(b)
+}
+
+func BenchmarkDelete(b *testing.B) {
+	heapBenchmark.BenchmarkDelete(b)
+}
+
+func BenchmarkMeld(b *testing.B) {
+	heapBenchmark.BenchmarkMeld(b)
+}
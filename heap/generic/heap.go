@@ -0,0 +1,76 @@
+package generic
+
+import (
+	"context"
+	"iter"
+
+	"github.com/zimmski/container"
+)
+
+// Handle defines a reference to a value which was pushed into a heap
+// Handles stay valid until their value is removed from the heap.
+type Handle[T any] interface {
+	// Get returns the current value of the handle
+	Get() T
+}
+
+// Heap defines a heap holding values of type T
+// Heaps are ordered by a compare function. The value which compares less than all other values is at the front of the heap, which makes a heap a min-heap by default.
+// Iterators, channels, sequences and slices return the values in heap order, which is from the front to the back of the heap. The iterators of a heap are container iterators, so that a heap for values of type interface{} implements container.Container.
+type Heap[T any] interface {
+	// Clear resets the heap to zero values and resets the heap's meta data
+	Clear()
+	// Len returns the current value count
+	Len() int
+	// Empty returns true if the current value count is zero
+	Empty() bool
+
+	// Chan returns a channel which iterates from the front to the back of the heap
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+	Chan(n int) <-chan T
+	// ChanBack returns a channel which iterates from the back to the front of the heap
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+	ChanBack(n int) <-chan T
+	// ChanContext returns a channel which iterates from the front to the back of the heap and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan T
+	// ChanBackContext returns a channel which iterates from the back to the front of the heap and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan T
+
+	// Iter returns an iterator which starts at the front of a snapshot of the heap, or nil if there are no values in the heap
+	Iter() container.Iterator
+	// IterBack returns an iterator which starts at the back of a snapshot of the heap, or nil if there are no values in the heap
+	IterBack() container.Iterator
+
+	// All returns a sequence which iterates from the front to the back of the heap
+	All() iter.Seq[T]
+	// Backward returns a sequence which iterates from the back to the front of the heap
+	Backward() iter.Seq[T]
+
+	// Contains returns true if a value which compares equal to the given id value exists in the heap, or false if it does not
+	Contains(id T) bool
+
+	// Copy returns an exact copy of the heap, handles of the heap are not valid for the copy
+	Copy() Heap[T]
+	// Slice returns a copy of the heap as a slice in heap order
+	Slice() []T
+
+	// Peek returns the front value of the heap and true, or false if there is no value
+	Peek() (T, bool)
+	// Push inserts the given value into the heap and returns its handle
+	Push(v T) Handle[T]
+	// Pop removes the front value of the heap and returns it and true, or false if there is no value
+	Pop() (T, bool)
+
+	// Update sets the value of the given handle and restores the heap order and returns true, or false if the handle is not part of the heap
+	Update(h Handle[T], v T) bool
+	// Fix restores the heap order after the value of the given handle was changed in place and returns true, or false if the handle is not part of the heap
+	Fix(h Handle[T]) bool
+	// DecreaseKey sets the value of the given handle to a value which is not behind its current value and returns true, or false if the handle is not part of the heap or if the value is behind the current value
+	DecreaseKey(h Handle[T], v T) bool
+	// Delete removes the value of the given handle and returns it and true, or false if the handle is not part of the heap
+	Delete(h Handle[T]) (T, bool)
+
+	// Meld moves all values of the given heap into the heap and clears the given heap
+	// Both heaps have to use the same compare function. Handles of the given heap stay valid for the heap if both heaps are of the same implementation.
+	Meld(h2 Heap[T])
+}
@@ -0,0 +1,105 @@
+package generic
+
+import (
+	"math/rand"
+	"runtime/debug"
+	"testing"
+)
+
+// HeapBenchmark is the base for all benchmarks of min-heaps holding int values
+type HeapBenchmark struct {
+	New func(b *testing.B) Heap[int]
+}
+
+// newFilledHeap returns a new heap with n random values and their handles
+func (hb *HeapBenchmark) newFilledHeap(b *testing.B, r *rand.Rand, n int) (Heap[int], []Handle[int]) {
+	h := hb.New(b)
+	hs := make([]Handle[int], n)
+
+	for i := range hs {
+		hs[i] = h.Push(r.Int())
+	}
+
+	return h, hs
+}
+
+func (hb *HeapBenchmark) BenchmarkPush(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	h := hb.New(b)
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.Push(r.Int())
+	}
+}
+
+func (hb *HeapBenchmark) BenchmarkPushPop(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	h, _ := hb.newFilledHeap(b, r, 1000)
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.Push(r.Int())
+		h.Pop()
+	}
+}
+
+func (hb *HeapBenchmark) BenchmarkDecreaseKey(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	h, hs := hb.newFilledHeap(b, r, 1000)
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		c := hs[r.Intn(len(hs))]
+
+		h.DecreaseKey(c, c.Get()-r.Intn(1000))
+	}
+}
+
+func (hb *HeapBenchmark) BenchmarkDelete(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	h, hs := hb.newFilledHeap(b, r, 1000)
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		j := r.Intn(len(hs))
+
+		h.Delete(hs[j])
+		hs[j] = h.Push(r.Int())
+	}
+}
+
+func (hb *HeapBenchmark) BenchmarkMeld(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	h, _ := hb.newFilledHeap(b, r, 1000)
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		o := hb.New(b)
+
+		for j := 0; j < 10; j++ {
+			o.Push(r.Int())
+		}
+
+		h.Meld(o)
+
+		for j := 0; j < 10; j++ {
+			h.Pop()
+		}
+	}
+}
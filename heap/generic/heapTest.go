@@ -0,0 +1,678 @@
+package generic
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+	"github.com/zimmski/go-leak"
+)
+
+// VRaw holds the unsorted value for basic heap tests
+var VRaw = []int{5, 3, 1, 4, 6, 2}
+
+// V holds the sorted value for basic heap tests
+var V = []int{1, 2, 3, 4, 5, 6}
+
+// VLen is the length of V
+var VLen = len(V)
+
+// HeapTest is the base for all tests of min-heaps holding int values
+type HeapTest struct {
+	New func(t *testing.T) Heap[int]
+}
+
+// Run executes all basic heap tests
+func (ht *HeapTest) Run(t *testing.T) {
+	ht.NewFilledHeap(t)
+
+	ht.TestBasic(t)
+	ht.TestIterator(t)
+	ht.TestChannels(t)
+	ht.TestChannelsContext(t)
+	ht.TestSeq(t)
+	ht.TestSlice(t)
+	ht.TestClear(t)
+	ht.TestCopy(t)
+	ht.TestContains(t)
+	ht.TestHandles(t)
+	ht.TestDecreaseKey(t)
+	ht.TestDelete(t)
+	ht.TestMeld(t)
+	ht.TestDuplicates(t)
+	ht.TestRandomOperations(t)
+}
+
+// FillHeap fills up a given heap with V
+func (ht *HeapTest) FillHeap(t *testing.T, h Heap[int]) []Handle[int] {
+	hs := make([]Handle[int], VLen)
+
+	for i, va := range VRaw {
+		hs[i] = h.Push(va)
+
+		Equal(t, h.Len(), i+1)
+		Equal(t, hs[i].Get(), va)
+
+		v, ok := h.Peek()
+		True(t, ok)
+		Equal(t, v, slices.Min(VRaw[:i+1]))
+	}
+
+	Equal(t, h.Len(), VLen)
+
+	return hs
+}
+
+// NewFilledHeap creates a new heap and calls FillHeap on it
+func (ht *HeapTest) NewFilledHeap(t *testing.T) Heap[int] {
+	h := ht.New(t)
+
+	ht.FillHeap(t, h)
+
+	return h
+}
+
+// TestBasic tests basic heap functionality
+func (ht *HeapTest) TestBasic(t *testing.T) {
+	h := ht.New(t)
+
+	Equal(t, h.Len(), 0)
+	True(t, h.Empty())
+
+	v, ok := h.Peek()
+	False(t, ok)
+	Equal(t, v, 0)
+	v, ok = h.Pop()
+	False(t, ok)
+	Equal(t, v, 0)
+
+	ht.FillHeap(t, h)
+
+	False(t, h.Empty())
+
+	for i := 0; i < VLen; i++ {
+		v, ok := h.Peek()
+		True(t, ok)
+		Equal(t, v, V[i])
+
+		v, ok = h.Pop()
+		True(t, ok)
+		Equal(t, v, V[i])
+		Equal(t, h.Len(), VLen-i-1)
+	}
+
+	True(t, h.Empty())
+
+	_, ok = h.Pop()
+	False(t, ok)
+
+	// the heap can be reused
+	h.Push(2)
+	h.Push(1)
+
+	v, ok = h.Pop()
+	True(t, ok)
+	Equal(t, v, 1)
+	Equal(t, h.Len(), 1)
+}
+
+// TestIterator tests iterators
+func (ht *HeapTest) TestIterator(t *testing.T) {
+	h := ht.New(t)
+
+	Nil(t, h.Iter())
+	Nil(t, h.IterBack())
+
+	h = ht.NewFilledHeap(t)
+
+	i := 0
+
+	for iter := h.Iter(); iter != nil; iter = iter.Next() {
+		Equal(t, iter.Get(), V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	i = VLen - 1
+
+	for iter := h.IterBack(); iter != nil; iter = iter.Previous() {
+		Equal(t, iter.Get(), V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// iterators can change their direction
+	iter := h.Iter()
+	Equal(t, iter.Next().Get(), V[1])
+	Equal(t, iter.Next().Get(), V[2])
+	Equal(t, iter.Previous().Get(), V[1])
+	Equal(t, iter.Previous().Get(), V[0])
+	Nil(t, iter.Previous())
+
+	// iterators do not modify the heap
+	Equal(t, h.Len(), VLen)
+}
+
+// TestChannels tests heap channels
+func (ht *HeapTest) TestChannels(t *testing.T) {
+	// empty channels
+	h := ht.New(t)
+
+	i := 0
+
+	for range h.Chan(0) {
+		i++
+	}
+	for range h.ChanBack(0) {
+		i++
+	}
+
+	Equal(t, i, 0)
+
+	// full channels
+	h = ht.NewFilledHeap(t)
+
+	i = 0
+
+	for v := range h.Chan(0) {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	i = VLen - 1
+
+	for v := range h.ChanBack(0) {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+}
+
+// TestChannelsContext tests buffered and cancellable heap channels
+func (ht *HeapTest) TestChannelsContext(t *testing.T) {
+	h := ht.NewFilledHeap(t)
+
+	// buffered channels
+	ch := h.Chan(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i := 0
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	ch = h.ChanBack(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i = VLen - 1
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// consumers which stop early
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := h.ChanContext(ctx, 0)
+		Equal(t, <-ch, V[0])
+
+		chBack := h.ChanBackContext(ctx, 1)
+		Equal(t, <-chBack, V[VLen-1])
+
+		cancel()
+
+		// wait until the goroutines noticed the cancellation and closed the channels
+		i := 0
+
+		for range ch {
+			i++
+		}
+		for range chBack {
+			i++
+		}
+
+		True(t, i < 2*VLen-3, "goroutines did not stop after the cancellation")
+	}))
+
+	// contexts which are already done
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for range h.ChanContext(ctx, 0) {
+		}
+		for range h.ChanBackContext(ctx, 0) {
+		}
+	}))
+}
+
+// TestSeq tests heap sequences
+func (ht *HeapTest) TestSeq(t *testing.T) {
+	// empty sequences
+	h := ht.New(t)
+
+	Equal(t, len(slices.Collect(h.All())), 0)
+	Equal(t, len(slices.Collect(h.Backward())), 0)
+
+	// full sequences
+	h = ht.NewFilledHeap(t)
+
+	Equal(t, slices.Collect(h.All()), V)
+
+	r := slices.Clone(V)
+	slices.Reverse(r)
+	Equal(t, slices.Collect(h.Backward()), r)
+
+	// sequences stop early
+	i := 0
+
+	for v := range h.All() {
+		Equal(t, v, V[i])
+
+		if i == 2 {
+			break
+		}
+
+		i++
+	}
+
+	Equal(t, i, 2)
+
+	// sequences can be reused and see modifications of the heap
+	s := h.All()
+
+	Equal(t, slices.Collect(s), V)
+
+	h.Pop()
+
+	Equal(t, slices.Collect(s), V[1:])
+}
+
+// TestSlice tests converting the heap to slice
+func (ht *HeapTest) TestSlice(t *testing.T) {
+	h := ht.New(t)
+
+	Equal(t, h.Slice(), []int{})
+
+	h = ht.NewFilledHeap(t)
+
+	Equal(t, h.Slice(), V)
+
+	// slices are copies
+	s := h.Slice()
+	s[0] = 100
+
+	Equal(t, h.Slice(), V)
+}
+
+// TestClear tests clearing the heap
+func (ht *HeapTest) TestClear(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	h.Clear()
+
+	Equal(t, h.Len(), 0)
+	True(t, h.Empty())
+	Nil(t, h.Iter())
+
+	// handles are not part of the heap anymore
+	False(t, h.Update(hs[0], 0))
+	False(t, h.Fix(hs[0]))
+
+	ht.FillHeap(t, h)
+}
+
+// TestCopy tests copying the heap
+func (ht *HeapTest) TestCopy(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	c := h.Copy()
+
+	Equal(t, c.Len(), VLen)
+	Equal(t, c.Slice(), V)
+
+	// the copy is independent of the original
+	c.Pop()
+	c.Push(0)
+
+	Equal(t, h.Slice(), V)
+	Equal(t, c.Slice(), append([]int{0}, V[1:]...))
+
+	// handles of the original are not valid for the copy
+	False(t, c.Update(hs[0], 100))
+
+	Equal(t, c.Slice(), append([]int{0}, V[1:]...))
+}
+
+// TestContains tests the contains method
+func (ht *HeapTest) TestContains(t *testing.T) {
+	h := ht.New(t)
+
+	False(t, h.Contains(V[0]))
+
+	h = ht.NewFilledHeap(t)
+
+	for _, v := range V {
+		True(t, h.Contains(v))
+	}
+
+	False(t, h.Contains(0))
+	False(t, h.Contains(V[VLen-1]+1))
+}
+
+// TestHandles tests updating values through their handles
+func (ht *HeapTest) TestHandles(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	// move the last value to the front
+	True(t, h.Update(hs[4], 0))
+	Equal(t, hs[4].Get(), 0)
+
+	v, ok := h.Peek()
+	True(t, ok)
+	Equal(t, v, 0)
+	Equal(t, h.Slice(), []int{0, 1, 2, 3, 4, 5})
+
+	// move the front value to the back
+	True(t, h.Update(hs[2], 10))
+
+	Equal(t, h.Slice(), []int{0, 2, 3, 4, 5, 10})
+
+	// update a value in the middle without changing its position
+	True(t, h.Update(hs[1], 3))
+	True(t, h.Fix(hs[1]))
+
+	Equal(t, h.Slice(), []int{0, 2, 3, 4, 5, 10})
+
+	// popped handles are not part of the heap anymore
+	v, ok = h.Pop()
+	True(t, ok)
+	Equal(t, v, 0)
+
+	False(t, h.Update(hs[4], 100))
+	False(t, h.Fix(hs[4]))
+	Equal(t, hs[4].Get(), 0)
+
+	// handles of other heaps are not part of the heap
+	o := ht.New(t)
+	oh := o.Push(1)
+
+	False(t, h.Update(oh, 1))
+	False(t, h.Fix(oh))
+	True(t, o.Update(oh, 2))
+
+	Equal(t, h.Slice(), []int{2, 3, 4, 5, 10})
+	Equal(t, o.Slice(), []int{2})
+}
+
+// TestDecreaseKey tests decreasing values through their handles
+func (ht *HeapTest) TestDecreaseKey(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	// values which are behind the current value are rejected
+	False(t, h.DecreaseKey(hs[0], 6))
+	Equal(t, hs[0].Get(), 5)
+
+	// values which are equal to the current value are accepted
+	True(t, h.DecreaseKey(hs[0], 5))
+
+	True(t, h.DecreaseKey(hs[4], 0))
+	Equal(t, hs[4].Get(), 0)
+	True(t, h.DecreaseKey(hs[0], -1))
+
+	Equal(t, h.Slice(), []int{-1, 0, 1, 2, 3, 4})
+
+	v, ok := h.Pop()
+	True(t, ok)
+	Equal(t, v, -1)
+
+	False(t, h.DecreaseKey(hs[0], -2))
+
+	// decrease every remaining value below the front value in reverse order
+	for i, j := range []int{3, 5, 1, 2, 4} {
+		True(t, h.DecreaseKey(hs[j], -10-i))
+
+		v, ok := h.Peek()
+		True(t, ok)
+		Equal(t, v, -10-i)
+	}
+
+	Equal(t, h.Slice(), []int{-14, -13, -12, -11, -10})
+}
+
+// TestDelete tests deleting values through their handles
+func (ht *HeapTest) TestDelete(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	// delete a value in the middle
+	v, ok := h.Delete(hs[3])
+	True(t, ok)
+	Equal(t, v, 4)
+	Equal(t, h.Slice(), []int{1, 2, 3, 5, 6})
+
+	// deleted handles are not part of the heap anymore
+	v, ok = h.Delete(hs[3])
+	False(t, ok)
+	Equal(t, v, 0)
+	False(t, h.Update(hs[3], 0))
+	False(t, h.DecreaseKey(hs[3], 0))
+
+	// delete the front value
+	v, ok = h.Delete(hs[2])
+	True(t, ok)
+	Equal(t, v, 1)
+
+	v, ok = h.Peek()
+	True(t, ok)
+	Equal(t, v, 2)
+
+	// delete the back value
+	v, ok = h.Delete(hs[4])
+	True(t, ok)
+	Equal(t, v, 6)
+	Equal(t, h.Slice(), []int{2, 3, 5})
+
+	for _, i := range []int{0, 1, 5} {
+		_, ok := h.Delete(hs[i])
+		True(t, ok)
+	}
+
+	True(t, h.Empty())
+
+	_, ok = h.Pop()
+	False(t, ok)
+}
+
+// TestMeld tests melding heaps
+func (ht *HeapTest) TestMeld(t *testing.T) {
+	h := ht.New(t)
+	hs := ht.FillHeap(t, h)
+
+	// melding empty heaps
+	h.Meld(ht.New(t))
+
+	Equal(t, h.Slice(), V)
+
+	e := ht.New(t)
+	e.Meld(h.Copy())
+
+	Equal(t, e.Slice(), V)
+
+	// melding the heap with itself does nothing
+	h.Meld(h)
+
+	Equal(t, h.Slice(), V)
+
+	o := ht.New(t)
+	oh := o.Push(0)
+	o.Push(7)
+	o.Push(3)
+
+	h.Meld(o)
+
+	Equal(t, h.Len(), VLen+3)
+	Equal(t, h.Slice(), []int{0, 1, 2, 3, 3, 4, 5, 6, 7})
+
+	// the melded heap is empty and can be reused
+	Equal(t, o.Len(), 0)
+	True(t, o.Empty())
+	Nil(t, o.Iter())
+
+	o.Push(10)
+
+	Equal(t, o.Slice(), []int{10})
+
+	// handles of both heaps are valid for the melded heap
+	True(t, h.Update(oh, 8))
+	True(t, h.DecreaseKey(hs[4], -1))
+	False(t, o.Update(oh, 9))
+
+	v, ok := h.Pop()
+	True(t, ok)
+	Equal(t, v, -1)
+
+	v, ok = h.Delete(oh)
+	True(t, ok)
+	Equal(t, v, 8)
+
+	Equal(t, h.Slice(), []int{1, 2, 3, 3, 4, 5, 7})
+
+	// handles stay valid over multiple melds
+	a := ht.New(t)
+	ah := a.Push(20)
+
+	b := ht.New(t)
+	b.Meld(a)
+	h.Meld(b)
+
+	True(t, h.DecreaseKey(ah, 0))
+
+	v, ok = h.Pop()
+	True(t, ok)
+	Equal(t, v, 0)
+}
+
+// TestDuplicates tests heaps with duplicated values
+func (ht *HeapTest) TestDuplicates(t *testing.T) {
+	h := ht.New(t)
+
+	for _, v := range []int{3, 1, 3, 2, 3, 1, 5} {
+		h.Push(v)
+	}
+
+	Equal(t, h.Slice(), []int{1, 1, 2, 3, 3, 3, 5})
+
+	for _, v := range []int{1, 1, 2, 3, 3, 3, 5} {
+		w, ok := h.Pop()
+		True(t, ok)
+		Equal(t, w, v)
+	}
+
+	True(t, h.Empty())
+}
+
+// TestRandomOperations tests the heap against a sorted slice with random operations
+func (ht *HeapTest) TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	h := ht.New(t)
+	var hs []Handle[int]
+
+	for i := 0; i < 2000; i++ {
+		switch r.Intn(8) {
+		case 0, 1, 2:
+			hs = append(hs, h.Push(r.Intn(100)))
+		case 3:
+			v, ok := h.Pop()
+			Equal(t, ok, len(hs) > 0)
+
+			if ok {
+				// remove the handle of the popped value
+				for j, c := range hs {
+					if !h.Fix(c) {
+						Equal(t, c.Get(), v)
+
+						hs = append(hs[:j], hs[j+1:]...)
+
+						break
+					}
+				}
+			}
+		case 4:
+			if len(hs) > 0 {
+				True(t, h.Update(hs[r.Intn(len(hs))], r.Intn(100)))
+			}
+		case 5:
+			if len(hs) > 0 {
+				c := hs[r.Intn(len(hs))]
+
+				True(t, h.DecreaseKey(c, c.Get()-r.Intn(10)))
+			}
+		case 6:
+			if len(hs) > 0 {
+				j := r.Intn(len(hs))
+
+				v, ok := h.Delete(hs[j])
+				True(t, ok)
+				Equal(t, v, hs[j].Get())
+
+				hs = append(hs[:j], hs[j+1:]...)
+			}
+		case 7:
+			o := ht.New(t)
+
+			for j := r.Intn(5); j > 0; j-- {
+				hs = append(hs, o.Push(r.Intn(100)))
+			}
+
+			h.Meld(o)
+
+			True(t, o.Empty())
+		}
+
+		s := make([]int, len(hs))
+		for j, c := range hs {
+			s[j] = c.Get()
+		}
+		sort.Ints(s)
+
+		Equal(t, h.Len(), len(s))
+
+		if len(s) > 0 {
+			v, ok := h.Peek()
+			True(t, ok)
+			Equal(t, v, s[0])
+		}
+
+		if i%50 == 0 {
+			Equal(t, h.Slice(), s)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package heap
+
+import (
+	"github.com/zimmski/container"
+	Generic "github.com/zimmski/container/heap/generic"
+)
+
+// Iterator defines a heap iterator
+type Iterator = container.Iterator
+
+// Handle defines a reference to a value which was pushed into a heap
+type Handle = Generic.Handle[interface{}]
+
+// Heap defines a heap
+// Heaps are ordered by a compare function. The value which compares less than all other values is at the front of the heap.
+type Heap = Generic.Heap[interface{}]
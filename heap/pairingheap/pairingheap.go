@@ -0,0 +1,524 @@
+package pairingheap
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+// iterator holds a snapshot iterator for a pairing heap
+type iterator[T any] struct {
+	snapshot []T // The values of the heap in heap order at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// Next iterates to the next value in the snapshot and returns the iterator, or nil if there is no next value
+func (iter *iterator[T]) Next() container.Iterator {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous value in the snapshot and returns the iterator, or nil if there is no previous value
+func (iter *iterator[T]) Previous() container.Iterator {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current position in the snapshot
+func (iter *iterator[T]) Get() interface{} {
+	return iter.snapshot[iter.i]
+}
+
+// owner identifies the heap of a node
+// Owners form a union-find structure so that melding a heap hands over all its nodes in O(1).
+type owner struct {
+	parent *owner // The owner this owner was melded into, or nil if it still identifies a heap
+}
+
+// find returns the owner which currently identifies the heap while compressing the path
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+
+		o = o.parent
+	}
+
+	return o
+}
+
+// node holds a single node of a pairing heap
+type node[T any] struct {
+	owner   *owner   // The owner of the node, or nil if the node was removed
+	child   *node[T] // The first child of the node
+	sibling *node[T] // The next sibling of the node
+	prev    *node[T] // The parent if this is the first child, otherwise the previous sibling
+	value   T        // The value of the node
+}
+
+// Get returns the current value of the node
+func (n *node[T]) Get() T {
+	return n.value
+}
+
+// heap holds a pairing heap
+// Push, Meld and DecreaseKey need O(1), Pop and Delete need amortized O(log n).
+type heap[T any] struct {
+	compare func(a, b T) int // The compare function of the heap
+	owner   *owner           // The owner of the heap's nodes
+	root    *node[T]         // The root node which holds the front value
+	len     int              // The current value count
+}
+
+// New returns a new pairing heap
+func New[T any](compare func(a, b T) int) *heap[T] {
+	h := new(heap[T])
+
+	h.compare = compare
+
+	h.Clear()
+
+	return h
+}
+
+// NewOrdered returns a new pairing heap for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *heap[T] {
+	return New(cmp.Compare[T])
+}
+
+// link links two detached root nodes and returns the root in front whose first child the other root becomes
+func (h *heap[T]) link(a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	} else if b == nil {
+		return a
+	}
+
+	if h.compare(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+
+	if a.child != nil {
+		a.child.prev = b
+	}
+
+	a.child = b
+
+	return a
+}
+
+// mergePairs links the sibling list starting with the given node in two passes and returns the new root
+func (h *heap[T]) mergePairs(first *node[T]) *node[T] {
+	// the first pass links pairs from left to right and stacks them through their sibling links
+	var pairs *node[T]
+
+	for first != nil {
+		a := first
+		b := a.sibling
+
+		if b != nil {
+			first = b.sibling
+
+			b.sibling = nil
+			b.prev = nil
+		} else {
+			first = nil
+		}
+
+		a.sibling = nil
+		a.prev = nil
+
+		c := h.link(a, b)
+		c.sibling = pairs
+
+		pairs = c
+	}
+
+	// the second pass links the pairs from right to left
+	var r *node[T]
+
+	for pairs != nil {
+		c := pairs
+		pairs = c.sibling
+
+		c.sibling = nil
+
+		r = h.link(c, r)
+	}
+
+	return r
+}
+
+// cut detaches the given node which is not the root from its parent and siblings
+func (h *heap[T]) cut(n *node[T]) {
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+
+	n.prev = nil
+	n.sibling = nil
+}
+
+// remove removes the given node from the heap
+func (h *heap[T]) remove(n *node[T]) {
+	if n == h.root {
+		h.root = h.mergePairs(n.child)
+	} else {
+		h.cut(n)
+
+		h.root = h.link(h.root, h.mergePairs(n.child))
+	}
+
+	n.owner = nil
+	n.child = nil
+
+	h.len--
+}
+
+// insert inserts the given detached node into the heap
+func (h *heap[T]) insert(n *node[T]) {
+	n.owner = h.owner
+
+	h.root = h.link(h.root, n)
+
+	h.len++
+}
+
+// own returns the node of the heap for the given handle, or nil if the handle is not part of the heap
+func (h *heap[T]) own(hd GenericHeap.Handle[T]) *node[T] {
+	n, ok := hd.(*node[T])
+	if !ok || n.owner == nil || n.owner.find() != h.owner {
+		return nil
+	}
+
+	return n
+}
+
+// walk calls the given function for every value of the heap in no specific order
+func (h *heap[T]) walk(f func(v T)) {
+	if h.root == nil {
+		return
+	}
+
+	stack := []*node[T]{h.root}
+
+	for len(stack) != 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for ; n != nil; n = n.sibling {
+			f(n.value)
+
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+		}
+	}
+}
+
+// Clear resets the heap to zero values and resets the heap's meta data
+func (h *heap[T]) Clear() {
+	h.owner = &owner{}
+	h.root = nil
+	h.len = 0
+}
+
+// Len returns the current value count
+func (h *heap[T]) Len() int {
+	return h.len
+}
+
+// Empty returns true if the current value count is zero
+func (h *heap[T]) Empty() bool {
+	return h.len == 0
+}
+
+// Chan returns a channel which iterates from the front to the back of the heap
+func (h *heap[T]) Chan(n int) <-chan T {
+	return h.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the heap
+func (h *heap[T]) ChanBack(n int) <-chan T {
+	return h.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the heap and which is closed early if the given context is done
+func (h *heap[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := h.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) Iter() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        0,
+	}
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the heap, or nil if there are no values in the heap
+func (h *heap[T]) IterBack() container.Iterator {
+	if h.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: h.Slice(),
+		i:        h.len - 1,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the heap
+func (h *heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range h.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the heap
+func (h *heap[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := h.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if a value which compares equal to the given id value exists in the heap, or false if it does not
+func (h *heap[T]) Contains(id T) bool {
+	found := false
+
+	h.walk(func(v T) {
+		if !found && h.compare(id, v) == 0 {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// Copy returns an exact copy of the heap, handles of the heap are not valid for the copy
+func (h *heap[T]) Copy() GenericHeap.Heap[T] {
+	h2 := New(h.compare)
+
+	h.walk(func(v T) {
+		h2.Push(v)
+	})
+
+	return h2
+}
+
+// Slice returns a copy of the heap as a slice in heap order
+// The values are sorted which needs O(n log n).
+func (h *heap[T]) Slice() []T {
+	s := make([]T, 0, h.len)
+
+	h.walk(func(v T) {
+		s = append(s, v)
+	})
+
+	slices.SortFunc(s, h.compare)
+
+	return s
+}
+
+// Peek returns the front value of the heap and true, or false if there is no value
+func (h *heap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var v T
+
+		return v, false
+	}
+
+	return h.root.value, true
+}
+
+// Push inserts the given value into the heap and returns its handle
+func (h *heap[T]) Push(v T) GenericHeap.Handle[T] {
+	n := &node[T]{
+		value: v,
+	}
+
+	h.insert(n)
+
+	return n
+}
+
+// Pop removes the front value of the heap and returns it and true, or false if there is no value
+func (h *heap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var v T
+
+		return v, false
+	}
+
+	n := h.root
+
+	h.remove(n)
+
+	return n.value, true
+}
+
+// Update sets the value of the given handle and restores the heap order and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Update(hd GenericHeap.Handle[T], v T) bool {
+	n := h.own(hd)
+	if n == nil {
+		return false
+	}
+
+	if h.compare(v, n.value) <= 0 {
+		return h.DecreaseKey(n, v)
+	}
+
+	h.remove(n)
+
+	n.value = v
+
+	h.insert(n)
+
+	return true
+}
+
+// Fix restores the heap order after the value of the given handle was changed in place and returns true, or false if the handle is not part of the heap
+func (h *heap[T]) Fix(hd GenericHeap.Handle[T]) bool {
+	n := h.own(hd)
+	if n == nil {
+		return false
+	}
+
+	h.remove(n)
+	h.insert(n)
+
+	return true
+}
+
+// DecreaseKey sets the value of the given handle to a value which is not behind its current value and returns true, or false if the handle is not part of the heap or if the value is behind the current value
+func (h *heap[T]) DecreaseKey(hd GenericHeap.Handle[T], v T) bool {
+	n := h.own(hd)
+	if n == nil || h.compare(v, n.value) > 0 {
+		return false
+	}
+
+	n.value = v
+
+	if n != h.root {
+		h.cut(n)
+
+		h.root = h.link(h.root, n)
+	}
+
+	return true
+}
+
+// Delete removes the value of the given handle and returns it and true, or false if the handle is not part of the heap
+func (h *heap[T]) Delete(hd GenericHeap.Handle[T]) (T, bool) {
+	n := h.own(hd)
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	h.remove(n)
+
+	return n.value, true
+}
+
+// Meld moves all values of the given heap into the heap and clears the given heap
+// Melding two pairing heaps needs O(1).
+func (h *heap[T]) Meld(h2 GenericHeap.Heap[T]) {
+	o, ok := h2.(*heap[T])
+	if !ok {
+		for !h2.Empty() {
+			v, _ := h2.Pop()
+
+			h.Push(v)
+		}
+
+		return
+	} else if o == h {
+		return
+	}
+
+	h.root = h.link(h.root, o.root)
+	h.len += o.len
+
+	o.owner.parent = h.owner
+
+	o.Clear()
+}
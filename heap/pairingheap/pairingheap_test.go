@@ -0,0 +1,140 @@
+package pairingheap
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	GenericHeap "github.com/zimmski/container/heap/generic"
+)
+
+func TestRunAllTests(t *testing.T) {
+	ht := &GenericHeap.HeapTest{
+		New: func(t *testing.T) GenericHeap.Heap[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	ht.Run(t)
+}
+
+// compareInts compares two int values which are stored as interface{}
+func compareInts(a, b interface{}) int {
+	return cmp.Compare(a.(int), b.(int))
+}
+
+func TestContainer(t *testing.T) {
+	var _ container.Container = New(compareInts)
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			h := New(compareInts)
+
+			for _, v := range vs {
+				h.Push(v)
+			}
+
+			return h
+		},
+	}
+
+	ct.Run(t)
+}
+
+// checkInvariants validates the links, the owners, the heap order and the length of the heap
+func checkInvariants[T any](t *testing.T, h *heap[T]) {
+	if h.root == nil {
+		Equal(t, h.len, 0)
+
+		return
+	}
+
+	Nil(t, h.root.prev)
+	Nil(t, h.root.sibling)
+
+	count := 0
+
+	var check func(n *node[T])
+	check = func(n *node[T]) {
+		count++
+
+		Equal(t, n.owner.find(), h.owner)
+
+		for c := n.child; c != nil; c = c.sibling {
+			if c == n.child {
+				Equal(t, c.prev, n)
+			} else {
+				Equal(t, c.prev.sibling, c)
+			}
+
+			True(t, h.compare(n.value, c.value) <= 0, "heap order is violated")
+
+			check(c)
+		}
+	}
+
+	check(h.root)
+
+	Equal(t, count, h.len)
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	h := NewOrdered[int]()
+	var hs []GenericHeap.Handle[int]
+
+	for i := 0; i < 3000; i++ {
+		switch r.Intn(6) {
+		case 0, 1:
+			hs = append(hs, h.Push(r.Intn(100)))
+		case 2:
+			h.Pop()
+		case 3:
+			if len(hs) > 0 {
+				h.Update(hs[r.Intn(len(hs))], r.Intn(100))
+			}
+		case 4:
+			if len(hs) > 0 {
+				c := hs[r.Intn(len(hs))]
+
+				h.DecreaseKey(c, c.Get()-r.Intn(20))
+			}
+		case 5:
+			if len(hs) > 0 {
+				h.Delete(hs[r.Intn(len(hs))])
+			}
+		}
+
+		checkInvariants(t, h)
+	}
+}
+
+var heapBenchmark = &GenericHeap.HeapBenchmark{
+	New: func(b *testing.B) GenericHeap.Heap[int] {
+		return NewOrdered[int]()
+	},
+}
+
+func BenchmarkPush(b *testing.B) {
+	heapBenchmark.BenchmarkPush(b)
+}
+
+func BenchmarkPushPop(b *testing.B) {
+	heapBenchmark.BenchmarkPushPop(b)
+}
+
+func BenchmarkDecreaseKey(b *testing.B) {
+	heapBenchmark.BenchmarkDecreaseKey(b)
+}
+
+func BenchmarkDelete(b *testing.B) {
+	heapBenchmark.BenchmarkDelete(b)
+}
+
+func BenchmarkMeld(b *testing.B) {
+	heapBenchmark.BenchmarkMeld(b)
+}
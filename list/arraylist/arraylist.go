@@ -0,0 +1,551 @@
+package arraylist
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// minCapacity is the capacity below which the array is never shrunk
+const minCapacity = 8
+
+// iterator holds the iterator for an array list
+type iterator[T any] struct {
+	list *list[T] // The list of this iterator
+	i    int      // The current index in the list
+}
+
+// Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
+	iter.i++
+
+	if iter.i < 0 || iter.i >= len(iter.list.values) {
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
+	iter.i--
+
+	if iter.i < 0 || iter.i >= len(iter.list.values) {
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element
+func (iter *iterator[T]) Get() T {
+	return iter.list.values[iter.i]
+}
+
+// Set sets the value of the iterator's current element
+func (iter *iterator[T]) Set(v T) {
+	iter.list.values[iter.i] = v
+}
+
+// list holds a list which is stored in one contiguous array
+// The array grows like an append to a slice and is halved when it is less than a quarter full, which makes Push and Pop amortized O(1).
+type list[T any] struct {
+	values []T // The values of the list
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
+
+// WithCapacity sets the initial capacity of the array
+func WithCapacity[T any](c int) Option[T] {
+	return func(l *list[T]) {
+		l.values = make([]T, 0, c)
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// New returns a new array list
+func New(opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new array list for values of type T
+func NewOf[T any](opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = equal[T]
+
+	for _, o := range opts {
+		o(l)
+	}
+
+	return l
+}
+
+// Clear resets the list to zero elements and resets the list's meta data
+func (l *list[T]) Clear() {
+	l.values = nil
+}
+
+// Len returns the current list length
+func (l *list[T]) Len() int {
+	return len(l.values)
+}
+
+// Empty returns true if the current list length is zero
+func (l *list[T]) Empty() bool {
+	return len(l.values) == 0
+}
+
+// shrink halves the capacity of the array if it is less than a quarter full
+func (l *list[T]) shrink() {
+	if c := cap(l.values); c > minCapacity && len(l.values) < c/4 {
+		values := make([]T, len(l.values), max(minCapacity, c/2))
+		copy(values, l.values)
+
+		l.values = values
+	}
+}
+
+// newIterator returns a new iterator
+func (l *list[T]) newIterator(i int) *iterator[T] {
+	return &iterator[T]{
+		list: l,
+		i:    i,
+	}
+}
+
+// Chan returns a channel which iterates from the front to the back of the list
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
+func (l *list[T]) Iter() GenericList.Iterator[T] {
+	if len(l.values) == 0 {
+		return nil
+	}
+
+	return l.newIterator(0)
+}
+
+// IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
+	if len(l.values) == 0 {
+		return nil
+	}
+
+	return l.newIterator(len(l.values) - 1)
+}
+
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(l.values); i++ {
+			if !yield(l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(l.values) - 1; i > -1; i-- {
+			if !yield(l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(l.values); i++ {
+			if !yield(i, l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the list and true, or false if there is no value
+func (l *list[T]) First() (T, bool) {
+	if len(l.values) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.values[0], true
+}
+
+// Last returns the last value of the list and true, or false if there is no value
+func (l *list[T]) Last() (T, bool) {
+	if len(l.values) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.values[len(l.values)-1], true
+}
+
+// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Get(i int) (T, error) {
+	if i < 0 || i >= len(l.values) {
+		var v T
+
+		return v, errors.New("index bounds out of range")
+	}
+
+	return l.values[i], nil
+}
+
+// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
+	if i := slices.IndexFunc(l.values, m); i > -1 {
+		return l.values[i], true
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Set(i int, v T) error {
+	if i < 0 || i >= len(l.values) {
+		return errors.New("index bounds out of range")
+	}
+
+	l.values[i] = v
+
+	return nil
+}
+
+// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
+	if i := slices.IndexFunc(l.values, m); i > -1 {
+		l.values[i] = v
+
+		return true
+	}
+
+	return false
+}
+
+// Swap swaps the value of index i with the value of index j
+func (l *list[T]) Swap(i, j int) {
+	if i < 0 || i >= len(l.values) || j < 0 || j >= len(l.values) {
+		return
+	}
+
+	l.values[i], l.values[j] = l.values[j], l.values[i]
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *list[T]) Contains(v T) bool {
+	_, ok := l.IndexOf(v)
+
+	return ok
+}
+
+// IndexOf returns the first index of the given value and true, or false if it does not exists
+func (l *list[T]) IndexOf(v T) (int, bool) {
+	for i, w := range l.values {
+		if l.equal(w, v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
+	for i := len(l.values) - 1; i > -1; i-- {
+		if l.equal(l.values[i], v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// Copy returns an exact copy of the list
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(WithEqual(l.equal))
+
+	n.values = slices.Clone(l.values)
+
+	return n
+}
+
+// Slice returns a copy of the list as slice
+func (l *list[T]) Slice() []T {
+	a := make([]T, len(l.values))
+
+	copy(a, l.values)
+
+	return a
+}
+
+// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Insert(i int, v T) error {
+	if i < 0 || i > len(l.values) {
+		return errors.New("index bounds out of range")
+	}
+
+	l.values = slices.Insert(l.values, i, v)
+
+	return nil
+}
+
+// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= len(l.values) {
+		var v T
+
+		return v, errors.New("index bounds out of range")
+	}
+
+	v := l.values[i]
+
+	l.values = slices.Delete(l.values, i, i+1)
+
+	l.shrink()
+
+	return v, nil
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	i, ok := l.IndexOf(v)
+	if ok {
+		l.Remove(i)
+	}
+
+	return ok
+}
+
+// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	i, ok := l.LastIndexOf(v)
+	if ok {
+		l.Remove(i)
+	}
+
+	return ok
+}
+
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *list[T]) Pop() (T, bool) {
+	if len(l.values) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	v, _ := l.Remove(len(l.values) - 1)
+
+	return v, true
+}
+
+// Push inserts the given value at the end of the list
+func (l *list[T]) Push(v T) {
+	l.values = append(l.values, v)
+}
+
+// PushList pushes the given list
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
+	l.values = append(l.values, l2.Slice()...)
+}
+
+// Shift removes and returns the first element and true, or false if there is no such element
+// All other values are moved which needs O(n).
+func (l *list[T]) Shift() (T, bool) {
+	if len(l.values) == 0 {
+		var v T
+
+		return v, false
+	}
+
+	v, _ := l.Remove(0)
+
+	return v, true
+}
+
+// Unshift inserts the given value at the beginning of the list
+// All other values are moved which needs O(n).
+func (l *list[T]) Unshift(v T) {
+	l.values = slices.Insert(l.values, 0, v)
+}
+
+// UnshiftList unshifts the given list
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
+	s := l2.Slice()
+
+	slices.Reverse(s)
+
+	l.values = slices.Insert(l.values, 0, s...)
+}
+
+// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveAfter(i, m int) error {
+	if i < 0 || i >= len(l.values) {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= len(l.values) {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i-1 == m {
+		return nil
+	}
+
+	l.move(i, m+1)
+
+	return nil
+}
+
+// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToBack(i int) error {
+	return l.MoveAfter(i, len(l.values)-1)
+}
+
+// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveBefore(i, m int) error {
+	if i < 0 || i >= len(l.values) {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= len(l.values) {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i == m-1 {
+		return nil
+	}
+
+	l.move(i, m)
+
+	return nil
+}
+
+// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToFront(i int) error {
+	return l.MoveBefore(i, 0)
+}
+
+// move moves the element at index i before the element which is at index m before the move by shifting the values in between
+func (l *list[T]) move(i, m int) {
+	v := l.values[i]
+
+	if i < m {
+		copy(l.values[i:m-1], l.values[i+1:m])
+
+		l.values[m-1] = v
+	} else {
+		copy(l.values[m+1:i+1], l.values[m:i])
+
+		l.values[m] = v
+	}
+}
+
+// compareFunc returns a compare function for the given less function
+func compareFunc[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	slices.SortFunc(l.values, compareFunc(less))
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	slices.SortStableFunc(l.values, compareFunc(less))
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	return slices.IsSortedFunc(l.values, compareFunc(less))
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	i := 0
+
+	for i < len(l.values) && !less(v, l.values[i]) {
+		i++
+	}
+
+	l.values = slices.Insert(l.values, i, v)
+}
@@ -0,0 +1,76 @@
+package arraylist
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+func TestRunAllTests(t *testing.T) {
+	lt := &List.ListTest{
+		New: func(t *testing.T) List.List {
+			return New()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf[int]()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestCapacity(t *testing.T) {
+	l := NewOf(WithCapacity[int](100))
+
+	Equal(t, cap(l.values), 100)
+
+	for i := 0; i < 100; i++ {
+		l.Push(i)
+	}
+
+	Equal(t, cap(l.values), 100)
+
+	// the array shrinks if it is less than a quarter full
+	for i := 0; i < 90; i++ {
+		v, ok := l.Pop()
+		True(t, ok)
+		Equal(t, v, 99-i)
+	}
+
+	Equal(t, cap(l.values), 25)
+	Equal(t, l.Slice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	// the array never shrinks below the minimal capacity
+	for i := 0; i < 10; i++ {
+		l.Shift()
+	}
+
+	True(t, l.Empty())
+	True(t, cap(l.values) >= minCapacity)
+}
+
+func BenchmarkAll(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.Run(b)
+}
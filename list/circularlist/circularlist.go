@@ -0,0 +1,88 @@
+package circularlist
+
+// Cursor defines a cursor which loops forever over the elements of a circular list
+// A new cursor is positioned in front of the first element, so that the first call of Next returns the first value.
+type Cursor[T any] interface {
+	// Next moves the cursor to the next element, which is the first element after the last element, and returns its value and true, or false if the list is empty
+	Next() (T, bool)
+	// Previous moves the cursor to the previous element, which is the last element before the first element, and returns its value and true, or false if the list is empty
+	Previous() (T, bool)
+
+	// Get returns the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+	Get() T
+	// Set sets the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+	Set(v T)
+
+	// Remove removes the cursor's current element and returns its value and true, or false if there is no current element
+	// The cursor stays between the neighbours of the removed element, so that Next returns the value after and Previous the value before the removed element.
+	Remove() (T, bool)
+}
+
+// config holds the configuration of a circular list
+type config[T any] struct {
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a circular list
+type Option[T any] func(c *config[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(c *config[T]) {
+		c.equal = equal
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// distance returns the distance between two indices
+func distance(i, j int) int {
+	if i < j {
+		return j - i
+	}
+
+	return i - j
+}
+
+// newConfig returns the configuration for the given options
+func newConfig[T any](opts []Option[T]) *config[T] {
+	c := &config[T]{
+		equal: equal[T],
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c
+}
+
+// rotation returns the index of the element which becomes the first element when rotating a list of length n by k
+func rotation(k, n int) int {
+	return ((k % n) + n) % n
+}
+
+// removeEvery removes every k-th element of a list with the given cursor until the list is empty and returns the removed values in the order of their removal
+func removeEvery[T any](c Cursor[T], n, k int) []T {
+	if k < 1 {
+		return nil
+	}
+
+	r := make([]T, 0, n)
+
+	for ; n > 0; n-- {
+		// only the remaining steps of a full circle have to be done
+		for i := (k-1)%n + 1; i > 0; i-- {
+			c.Next()
+		}
+
+		v, _ := c.Remove()
+
+		r = append(r, v)
+	}
+
+	return r
+}
@@ -0,0 +1,646 @@
+package circularlist
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// circularList defines the methods which both circular list variants provide
+type circularList interface {
+	GenericList.List[int]
+
+	Rotate(k int)
+	Cursor() Cursor[int]
+	RemoveEvery(k int) []int
+}
+
+var variants = []struct {
+	name string
+	new  func() circularList
+}{
+	{
+		name: "doubly",
+		new: func() circularList {
+			return NewOf[int]()
+		},
+	},
+	{
+		name: "singly",
+		new: func() circularList {
+			return NewSinglyOf[int]()
+		},
+	},
+}
+
+func fill(l circularList, n int) {
+	for i := 1; i <= n; i++ {
+		l.Push(i)
+	}
+}
+
+func TestRunAllTests(t *testing.T) {
+	t.Run("doubly", func(t *testing.T) {
+		lt := &List.ListTest{
+			New: func(t *testing.T) List.List {
+				return New()
+			},
+			NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+				return New(WithEqual(equal))
+			},
+		}
+
+		lt.Run(t)
+	})
+	t.Run("singly", func(t *testing.T) {
+		lt := &List.ListTest{
+			New: func(t *testing.T) List.List {
+				return NewSingly()
+			},
+			NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+				return NewSingly(WithEqual(equal))
+			},
+		}
+
+		lt.Run(t)
+	})
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	t.Run("doubly", func(t *testing.T) {
+		lt := &GenericList.ListTest{
+			New: func(t *testing.T) GenericList.List[int] {
+				return NewOf[int]()
+			},
+			NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+				return NewOf(WithEqual(equal))
+			},
+		}
+
+		lt.Run(t)
+	})
+	t.Run("singly", func(t *testing.T) {
+		lt := &GenericList.ListTest{
+			New: func(t *testing.T) GenericList.List[int] {
+				return NewSinglyOf[int]()
+			},
+			NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+				return NewSinglyOf(WithEqual(equal))
+			},
+		}
+
+		lt.Run(t)
+	})
+}
+
+func TestRotate(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			l := variant.new()
+
+			// rotating an empty list does nothing
+			l.Rotate(3)
+			Equal(t, l.Len(), 0)
+
+			fill(l, 5)
+
+			l.Rotate(0)
+			Equal(t, l.Slice(), []int{1, 2, 3, 4, 5})
+
+			l.Rotate(1)
+			Equal(t, l.Slice(), []int{2, 3, 4, 5, 1})
+
+			l.Rotate(-1)
+			Equal(t, l.Slice(), []int{1, 2, 3, 4, 5})
+
+			l.Rotate(7)
+			Equal(t, l.Slice(), []int{3, 4, 5, 1, 2})
+
+			l.Rotate(-12)
+			Equal(t, l.Slice(), []int{1, 2, 3, 4, 5})
+
+			l.Rotate(5)
+			Equal(t, l.Slice(), []int{1, 2, 3, 4, 5})
+
+			// the list stays intact after rotating
+			l.Rotate(3)
+			l.Push(6)
+			l.Unshift(0)
+			Equal(t, l.Slice(), []int{0, 4, 5, 1, 2, 3, 6})
+
+			v, _ := l.First()
+			Equal(t, v, 0)
+			v, _ = l.Last()
+			Equal(t, v, 6)
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			l := variant.new()
+			c := l.Cursor()
+
+			// an empty list has no elements to loop over
+			_, ok := c.Next()
+			False(t, ok)
+			_, ok = c.Previous()
+			False(t, ok)
+			_, ok = c.Remove()
+			False(t, ok)
+
+			fill(l, 3)
+
+			// the cursor loops forever in both directions
+			var vs []int
+
+			for i := 0; i < 7; i++ {
+				v, ok := c.Next()
+				True(t, ok)
+
+				vs = append(vs, v)
+			}
+
+			Equal(t, vs, []int{1, 2, 3, 1, 2, 3, 1})
+
+			vs = nil
+
+			for i := 0; i < 7; i++ {
+				v, ok := c.Previous()
+				True(t, ok)
+
+				vs = append(vs, v)
+			}
+
+			Equal(t, vs, []int{3, 2, 1, 3, 2, 1, 3})
+
+			// a new cursor starts in front of the first element in both directions
+			v, _ := l.Cursor().Previous()
+			Equal(t, v, 3)
+
+			c = l.Cursor()
+
+			v, _ = c.Next()
+			Equal(t, v, 1)
+			Equal(t, c.Get(), 1)
+
+			c.Set(10)
+			Equal(t, c.Get(), 10)
+			Equal(t, l.Slice(), []int{10, 2, 3})
+
+			// the cursor stays between the neighbours of a removed element
+			c.Next()
+
+			v, ok = c.Remove()
+			True(t, ok)
+			Equal(t, v, 2)
+			Equal(t, l.Slice(), []int{10, 3})
+
+			_, ok = c.Remove()
+			False(t, ok)
+
+			v, _ = c.Next()
+			Equal(t, v, 3)
+
+			c.Remove()
+
+			v, _ = c.Previous()
+			Equal(t, v, 10)
+
+			v, _ = c.Remove()
+			Equal(t, v, 10)
+			Equal(t, l.Len(), 0)
+
+			_, ok = c.Next()
+			False(t, ok)
+
+			// the cursor starts over if its element was removed from the list
+			fill(l, 3)
+
+			c.Next()
+			c.Next()
+
+			l.Remove(1)
+
+			v, _ = c.Next()
+			Equal(t, v, 1)
+		})
+	}
+}
+
+func TestRemoveEvery(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			l := variant.new()
+
+			Equal(t, l.RemoveEvery(3), []int{})
+
+			fill(l, 7)
+
+			Nil(t, l.RemoveEvery(0))
+			Equal(t, l.Len(), 7)
+
+			Equal(t, l.RemoveEvery(3), []int{3, 6, 2, 7, 5, 1, 4})
+			Equal(t, l.Len(), 0)
+
+			fill(l, 5)
+			Equal(t, l.RemoveEvery(1), []int{1, 2, 3, 4, 5})
+
+			fill(l, 5)
+			Equal(t, l.RemoveEvery(12), []int{2, 1, 5, 4, 3})
+
+			// the survivor for k = 2 is 2 * (n - 2^floor(log2(n))) + 1
+			fill(l, 41)
+
+			r := l.RemoveEvery(2)
+			Equal(t, len(r), 41)
+			Equal(t, r[40], 19)
+		})
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+
+			l := variant.new()
+			var s []int
+
+			for i := 0; i < 5000; i++ {
+				v := r.Intn(1000)
+
+				switch r.Intn(7) {
+				case 0:
+					l.Push(v)
+					s = append(s, v)
+				case 1:
+					l.Unshift(v)
+					s = append([]int{v}, s...)
+				case 2:
+					j := r.Intn(len(s) + 1)
+
+					Nil(t, l.Insert(j, v))
+					s = append(s[:j], append([]int{v}, s[j:]...)...)
+				case 3:
+					if len(s) > 0 {
+						j := r.Intn(len(s))
+
+						w, err := l.Remove(j)
+						Nil(t, err)
+						Equal(t, w, s[j])
+
+						s = append(s[:j], s[j+1:]...)
+					}
+				case 4:
+					if w, ok := l.Pop(); ok {
+						Equal(t, w, s[len(s)-1])
+
+						s = s[:len(s)-1]
+					}
+				case 5:
+					if w, ok := l.Shift(); ok {
+						Equal(t, w, s[0])
+
+						s = s[1:]
+					}
+				case 6:
+					if len(s) > 0 {
+						k := r.Intn(2*len(s)) - len(s)
+
+						l.Rotate(k)
+
+						k = rotation(k, len(s))
+						s = append(append([]int{}, s[k:]...), s[:k]...)
+					}
+				}
+
+				Equal(t, l.Len(), len(s))
+
+				if i%50 == 0 {
+					Equal(t, l.Slice(), append([]int{}, s...))
+				}
+			}
+		})
+	}
+}
+
+func TestFingerDoubly(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	l := NewOf[int]()
+	var s []int
+
+	checkFinger := func() {
+		if l.finger != nil {
+			n := l.head
+
+			for j := 0; j < l.fingerIndex; j++ {
+				n = n.next
+			}
+
+			True(t, n == l.finger)
+		}
+	}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(9) {
+		case 0, 1:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Get(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+			}
+		case 2:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				Nil(t, l.Set(j, v))
+				s[j] = v
+			}
+		case 3:
+			j := r.Intn(len(s) + 1)
+
+			Nil(t, l.Insert(j, v))
+			s = append(s[:j], append([]int{v}, s[j:]...)...)
+		case 4:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Remove(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+
+				s = append(s[:j], s[j+1:]...)
+			}
+		case 5:
+			if r.Intn(2) == 0 {
+				l.Push(v)
+				s = append(s, v)
+			} else {
+				l.Unshift(v)
+				s = append([]int{v}, s...)
+			}
+		case 6:
+			if r.Intn(2) == 0 {
+				if w, ok := l.Pop(); ok {
+					Equal(t, w, s[len(s)-1])
+
+					s = s[:len(s)-1]
+				}
+			} else if w, ok := l.Shift(); ok {
+				Equal(t, w, s[0])
+
+				s = s[1:]
+			}
+		case 7:
+			k := slices.Index(s, v)
+
+			if r.Intn(2) == 0 {
+				Equal(t, l.RemoveFirstOccurrence(v), k != -1)
+			} else {
+				for j, w := range s {
+					if w == v {
+						k = j
+					}
+				}
+
+				Equal(t, l.RemoveLastOccurrence(v), k != -1)
+			}
+
+			if k != -1 {
+				s = append(s[:k], s[k+1:]...)
+			}
+		case 8:
+			less := func(a, b int) bool {
+				return a < b
+			}
+
+			if r.Intn(10) == 0 {
+				l.Sort(less)
+				slices.Sort(s)
+			} else {
+				l.InsertSorted(v, less)
+
+				j := slices.IndexFunc(s, func(w int) bool {
+					return v < w
+				})
+				if j == -1 {
+					j = len(s)
+				}
+
+				s = append(s[:j], append([]int{v}, s[j:]...)...)
+			}
+		}
+
+		checkFinger()
+
+		Equal(t, l.Len(), len(s))
+
+		if i%50 == 0 {
+			Equal(t, l.Slice(), append([]int{}, s...))
+		}
+	}
+}
+
+func TestFingerSingly(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	l := NewSinglyOf[int]()
+	var s []int
+
+	checkFinger := func() {
+		if l.finger != nil {
+			n := l.last.next
+
+			for j := 0; j < l.fingerIndex; j++ {
+				n = n.next
+			}
+
+			True(t, n == l.finger)
+		}
+	}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(9) {
+		case 0, 1:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Get(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+			}
+		case 2:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				Nil(t, l.Set(j, v))
+				s[j] = v
+			}
+		case 3:
+			j := r.Intn(len(s) + 1)
+
+			Nil(t, l.Insert(j, v))
+			s = append(s[:j], append([]int{v}, s[j:]...)...)
+		case 4:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Remove(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+
+				s = append(s[:j], s[j+1:]...)
+			}
+		case 5:
+			if r.Intn(2) == 0 {
+				l.Push(v)
+				s = append(s, v)
+			} else {
+				l.Unshift(v)
+				s = append([]int{v}, s...)
+			}
+		case 6:
+			if r.Intn(2) == 0 {
+				if w, ok := l.Pop(); ok {
+					Equal(t, w, s[len(s)-1])
+
+					s = s[:len(s)-1]
+				}
+			} else if w, ok := l.Shift(); ok {
+				Equal(t, w, s[0])
+
+				s = s[1:]
+			}
+		case 7:
+			k := slices.Index(s, v)
+
+			if r.Intn(2) == 0 {
+				Equal(t, l.RemoveFirstOccurrence(v), k != -1)
+			} else {
+				for j, w := range s {
+					if w == v {
+						k = j
+					}
+				}
+
+				Equal(t, l.RemoveLastOccurrence(v), k != -1)
+			}
+
+			if k != -1 {
+				s = append(s[:k], s[k+1:]...)
+			}
+		case 8:
+			less := func(a, b int) bool {
+				return a < b
+			}
+
+			if r.Intn(10) == 0 {
+				l.Sort(less)
+				slices.Sort(s)
+			} else {
+				l.InsertSorted(v, less)
+
+				j := slices.IndexFunc(s, func(w int) bool {
+					return v < w
+				})
+				if j == -1 {
+					j = len(s)
+				}
+
+				s = append(s[:j], append([]int{v}, s[j:]...)...)
+			}
+		}
+
+		checkFinger()
+
+		Equal(t, l.Len(), len(s))
+
+		if i%50 == 0 {
+			Equal(t, l.Slice(), append([]int{}, s...))
+		}
+	}
+}
+
+func BenchmarkPushSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkPushSequentiel(b)
+}
+
+func BenchmarkUnshiftSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkUnshiftSequentiel(b)
+}
+
+func BenchmarkPushShiftSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkPushShiftSequentiel(b)
+}
+
+func BenchmarkGetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetSequentiel(b)
+}
+
+func BenchmarkGetBackwardSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetBackwardSequentiel(b)
+}
+
+func BenchmarkSetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkSetSequentiel(b)
+}
+
+func BenchmarkInsertRemoveSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkInsertRemoveSequentiel(b)
+}
@@ -0,0 +1,895 @@
+package circularlist
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// node holds a single node of a doubly linked circular list
+type node[T any] struct {
+	next     *node[T] // The node after this node in the list, or nil if the node was removed
+	previous *node[T] // The node before this node in the list, or nil if the node was removed
+	value    T        // The value stored with this node
+}
+
+// iterator holds the iterator for a doubly linked circular list
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	list    *list[T] // The list to which this iterator belongs
+}
+
+// Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
+	if iter.current == nil || iter.list.len == 0 || iter.current == iter.list.head.previous {
+		iter.current = nil
+		iter.list = nil
+
+		return nil
+	}
+
+	iter.current = iter.current.next
+
+	return iter
+}
+
+// Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
+	if iter.current == nil || iter.list.len == 0 || iter.current == iter.list.head {
+		iter.current = nil
+		iter.list = nil
+
+		return nil
+	}
+
+	iter.current = iter.current.previous
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element
+func (iter *iterator[T]) Get() T {
+	return iter.current.value
+}
+
+// Set sets the value of the iterator's current element
+func (iter *iterator[T]) Set(v T) {
+	iter.current.value = v
+}
+
+// cursor holds a cursor which loops forever over a doubly linked circular list
+type cursor[T any] struct {
+	list     *list[T] // The list to which this cursor belongs
+	current  *node[T] // The current node, or nil if the cursor is not on an element
+	previous *node[T] // The node before the cursor if it is not on an element
+	next     *node[T] // The node after the cursor if it is not on an element
+}
+
+// Next moves the cursor to the next element, which is the first element after the last element, and returns its value and true, or false if the list is empty
+func (c *cursor[T]) Next() (T, bool) {
+	if c.list.len == 0 {
+		return c.reset()
+	}
+
+	switch {
+	case c.current != nil && c.current.next != nil:
+		c.current = c.current.next
+	case c.current == nil && c.next != nil && c.next.next != nil:
+		c.current = c.next
+	default:
+		c.current = c.list.head
+	}
+
+	c.previous = nil
+	c.next = nil
+
+	return c.current.value, true
+}
+
+// Previous moves the cursor to the previous element, which is the last element before the first element, and returns its value and true, or false if the list is empty
+func (c *cursor[T]) Previous() (T, bool) {
+	if c.list.len == 0 {
+		return c.reset()
+	}
+
+	switch {
+	case c.current != nil && c.current.previous != nil:
+		c.current = c.current.previous
+	case c.current == nil && c.previous != nil && c.previous.previous != nil:
+		c.current = c.previous
+	default:
+		c.current = c.list.head.previous
+	}
+
+	c.previous = nil
+	c.next = nil
+
+	return c.current.value, true
+}
+
+// reset moves the cursor in front of the first element
+func (c *cursor[T]) reset() (T, bool) {
+	c.current = nil
+	c.previous = nil
+	c.next = nil
+
+	var v T
+
+	return v, false
+}
+
+// Get returns the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+func (c *cursor[T]) Get() T {
+	return c.current.value
+}
+
+// Set sets the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+func (c *cursor[T]) Set(v T) {
+	c.current.value = v
+}
+
+// Remove removes the cursor's current element and returns its value and true, or false if there is no current element
+func (c *cursor[T]) Remove() (T, bool) {
+	if c.current == nil || c.current.next == nil {
+		var v T
+
+		return v, false
+	}
+
+	c.previous = c.current.previous
+	c.next = c.current.next
+
+	v := c.list.removeNode(c.current, -1)
+
+	c.current = nil
+
+	return v, true
+}
+
+// list holds a doubly linked circular list
+type list[T any] struct {
+	head *node[T] // The first node of the list, its previous node is the last node of the list
+	len  int      // The current list length
+
+	finger      *node[T] // The last accessed node, or nil if there is none
+	fingerIndex int      // The index of the last accessed node
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// New returns a new doubly linked circular list
+func New(opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new doubly linked circular list for values of type T
+func NewOf[T any](opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = newConfig(opts).equal
+
+	l.Clear()
+
+	return l
+}
+
+// Clear resets the list to zero elements and resets the list's meta data
+func (l *list[T]) Clear() {
+	i := l.head
+
+	for ; l.len > 0; l.len-- {
+		j := i.next
+
+		i.next = nil
+		i.previous = nil
+
+		i = j
+	}
+
+	l.head = nil
+	l.len = 0
+
+	l.finger = nil
+}
+
+// Len returns the current list length
+func (l *list[T]) Len() int {
+	return l.len
+}
+
+// Empty returns true if the current list length is zero
+func (l *list[T]) Empty() bool {
+	return l.len == 0
+}
+
+// newNode returns a new node for the list
+func (l *list[T]) newNode(v T) *node[T] {
+	return &node[T]{
+		value: v,
+	}
+}
+
+// getNode returns the node with the given index or nil
+// The node is searched from the first, the last or the last accessed node, whichever is the closest, and becomes the last accessed node.
+func (l *list[T]) getNode(i int) (*node[T], error) {
+	if i < 0 || i >= l.len {
+		return nil, errors.New("index bounds out of range")
+	}
+
+	n, j := l.head, 0
+
+	if l.len-1-i < i {
+		n, j = l.head.previous, l.len-1
+	}
+
+	if l.finger != nil && distance(i, l.fingerIndex) < distance(i, j) {
+		n, j = l.finger, l.fingerIndex
+	}
+
+	for ; j < i; j++ {
+		n = n.next
+	}
+
+	for ; j > i; j-- {
+		n = n.previous
+	}
+
+	l.finger = n
+	l.fingerIndex = i
+
+	return n, nil
+}
+
+// insertNodeBefore creates a new node from a value, inserts it before a given node and returns the new one
+// The head of the list is only changed if the list was empty. The index i of the new node keeps the last accessed node up to date.
+func (l *list[T]) insertNodeBefore(v T, p *node[T], i int) *node[T] {
+	n := l.newNode(v)
+
+	if l.finger != nil && i <= l.fingerIndex {
+		l.fingerIndex++
+	}
+
+	if l.len == 0 {
+		n.next = n
+		n.previous = n
+
+		l.head = n
+	} else {
+		n.next = p
+		n.previous = p.previous
+		p.previous.next = n
+		p.previous = n
+	}
+
+	l.len++
+
+	return n
+}
+
+// removeNode removes a given node from the list
+// The index i of the node keeps the last accessed node up to date, it is forgotten if i is -1.
+func (l *list[T]) removeNode(c *node[T], i int) T {
+	if c == l.finger || i < 0 {
+		l.finger = nil
+	} else if l.finger != nil && i < l.fingerIndex {
+		l.fingerIndex--
+	}
+
+	if l.len == 1 {
+		l.head = nil
+	} else {
+		c.previous.next = c.next
+		c.next.previous = c.previous
+
+		if c == l.head {
+			l.head = c.next
+		}
+	}
+
+	c.next = nil
+	c.previous = nil
+
+	l.len--
+
+	return c.value
+}
+
+// newIterator returns a new iterator
+func (l *list[T]) newIterator(current *node[T]) *iterator[T] {
+	return &iterator[T]{
+		current: current,
+		list:    l,
+	}
+}
+
+// Chan returns a channel which iterates from the front to the back of the list
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
+// The iterator stops at the back of the list, use Cursor to loop over the list forever.
+func (l *list[T]) Iter() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(l.head)
+}
+
+// IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
+// The iterator stops at the front of the list, use Cursor to loop over the list forever.
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(l.head.previous)
+}
+
+// Cursor returns a cursor which loops forever over the list and which is positioned in front of the first element
+func (l *list[T]) Cursor() Cursor[T] {
+	return &cursor[T]{
+		list: l,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
+// First returns the first value of the list and true, or false if there is no value
+func (l *list[T]) First() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.head.value, true
+}
+
+// Last returns the last value of the list and true, or false if there is no value
+func (l *list[T]) Last() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.head.previous.value, true
+}
+
+// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Get(i int) (T, error) {
+	n, err := l.getNode(i)
+
+	if err != nil {
+		var v T
+
+		return v, err
+	}
+
+	return n.value, nil
+}
+
+// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
+	n := l.head
+
+	for i := 0; i < l.len; i++ {
+		if m(n.value) {
+			return n.value, true
+		}
+
+		n = n.next
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Set(i int, v T) error {
+	n, err := l.getNode(i)
+
+	if err != nil {
+		return err
+	}
+
+	n.value = v
+
+	return nil
+}
+
+// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
+	n := l.head
+
+	for i := 0; i < l.len; i++ {
+		if m(n.value) {
+			n.value = v
+
+			return true
+		}
+
+		n = n.next
+	}
+
+	return false
+}
+
+// Swap swaps the value of index i with the value of index j
+func (l *list[T]) Swap(i, j int) {
+	ni, erri := l.getNode(i)
+	nj, errj := l.getNode(j)
+
+	if erri == nil && errj == nil {
+		ni.value, nj.value = nj.value, ni.value
+	}
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *list[T]) Contains(v T) bool {
+	_, ok := l.IndexOf(v)
+
+	return ok
+}
+
+// IndexOf returns the first index of the given value and true, or false if it does not exists
+func (l *list[T]) IndexOf(v T) (int, bool) {
+	n := l.head
+
+	for i := 0; i < l.len; i++ {
+		if l.equal(n.value, v) {
+			return i, true
+		}
+
+		n = n.next
+	}
+
+	return -1, false
+}
+
+// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
+	if l.len == 0 {
+		return -1, false
+	}
+
+	n := l.head.previous
+
+	for i := l.len - 1; i >= 0; i-- {
+		if l.equal(n.value, v) {
+			return i, true
+		}
+
+		n = n.previous
+	}
+
+	return -1, false
+}
+
+// Copy returns an exact copy of the list
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(WithEqual(l.equal))
+
+	for v := range l.All() {
+		n.Push(v)
+	}
+
+	return n
+}
+
+// Slice returns a copy of the list as slice
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
+
+	n := l.head
+
+	for i := range a {
+		a[i] = n.value
+
+		n = n.next
+	}
+
+	return a
+}
+
+// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Insert(i int, v T) error {
+	if i < 0 || i > l.len {
+		return errors.New("index bounds out of range")
+	}
+
+	if i == 0 {
+		l.Unshift(v)
+	} else if i == l.len {
+		l.Push(v)
+	} else {
+		p, _ := l.getNode(i)
+
+		l.insertNodeBefore(v, p, i)
+	}
+
+	return nil
+}
+
+// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Remove(i int) (T, error) {
+	c, err := l.getNode(i)
+
+	if err != nil {
+		var v T
+
+		return v, err
+	}
+
+	n := c.next
+
+	v := l.removeNode(c, i)
+
+	// the next node moves to the index of the removed node and becomes the last accessed node
+	if i < l.len {
+		l.finger = n
+		l.fingerIndex = i
+	}
+
+	return v, nil
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	n := l.head
+
+	for i := 0; i < l.len; i++ {
+		if l.equal(n.value, v) {
+			l.removeNode(n, i)
+
+			return true
+		}
+
+		n = n.next
+	}
+
+	return false
+}
+
+// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	if l.len == 0 {
+		return false
+	}
+
+	n := l.head.previous
+
+	for i := l.len - 1; i >= 0; i-- {
+		if l.equal(n.value, v) {
+			l.removeNode(n, i)
+
+			return true
+		}
+
+		n = n.previous
+	}
+
+	return false
+}
+
+// RemoveEvery removes every k-th element going round the list until the list is empty and returns the removed values in the order of their removal, or nil if k is smaller than 1
+// Counting starts at the first element, so the last returned value is the survivor of the Josephus problem.
+func (l *list[T]) RemoveEvery(k int) []T {
+	return removeEvery(l.Cursor(), l.len, k)
+}
+
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *list[T]) Pop() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.removeNode(l.head.previous, l.len-1), true
+}
+
+// Push inserts the given value at the end of the list
+func (l *list[T]) Push(v T) {
+	l.insertNodeBefore(v, l.head, l.len)
+}
+
+// PushList pushes the given list
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Push(v)
+	}
+}
+
+// Shift removes and returns the first element and true, or false if there is no such element
+func (l *list[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.removeNode(l.head, 0), true
+}
+
+// Unshift inserts the given value at the beginning of the list
+func (l *list[T]) Unshift(v T) {
+	l.head = l.insertNodeBefore(v, l.head, 0)
+}
+
+// UnshiftList unshifts the given list
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Unshift(v)
+	}
+}
+
+// Rotate rotates the list by k elements so that the element at index k becomes the first element, a negative k rotates in the other direction
+func (l *list[T]) Rotate(k int) {
+	if l.len == 0 {
+		return
+	}
+
+	l.head, _ = l.getNode(rotation(k, l.len))
+
+	// the nodes are moved to other indices
+	l.finger = nil
+}
+
+// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveAfter(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i-1 == m {
+		return nil
+	}
+
+	v, _ := l.Remove(i)
+
+	if i < m {
+		m--
+	}
+
+	l.Insert(m+1, v)
+
+	return nil
+}
+
+// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToBack(i int) error {
+	return l.MoveAfter(i, l.len-1)
+}
+
+// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveBefore(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i == m-1 {
+		return nil
+	}
+
+	v, _ := l.Remove(i)
+
+	if i < m {
+		m--
+	}
+
+	l.Insert(m, v)
+
+	return nil
+}
+
+// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToFront(i int) error {
+	return l.MoveBefore(i, 0)
+}
+
+// mergeSort sorts the list by relinking its nodes with a stable bottom-up merge sort
+func (l *list[T]) mergeSort(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// the nodes are moved to other indices
+	l.finger = nil
+
+	// the circle is opened for sorting and closed again afterwards
+	l.head.previous.next = nil
+
+	for k := 1; k < l.len; k *= 2 {
+		var first, last *node[T]
+
+		p := l.head
+
+		for p != nil {
+			// the run starting at p and the run starting at q are merged, both have at most k nodes
+			q := p
+			ps := 0
+
+			for ps < k && q != nil {
+				q = q.next
+				ps++
+			}
+
+			qs := k
+
+			for ps > 0 || (qs > 0 && q != nil) {
+				var c *node[T]
+
+				// take the node of the first run if the values are equal to keep the sort stable
+				if qs == 0 || q == nil || (ps > 0 && !less(q.value, p.value)) {
+					c = p
+					p = p.next
+					ps--
+				} else {
+					c = q
+					q = q.next
+					qs--
+				}
+
+				if last == nil {
+					first = c
+				} else {
+					last.next = c
+				}
+
+				last = c
+			}
+
+			p = q
+		}
+
+		last.next = nil
+
+		l.head = first
+	}
+
+	// only the next links are sorted, so the previous links and the circle have to be restored
+	p := l.head
+
+	for p.next != nil {
+		p.next.previous = p
+
+		p = p.next
+	}
+
+	p.next = l.head
+	l.head.previous = p
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	n := l.head
+
+	for i := 1; i < l.len; i++ {
+		if less(n.next.value, n.value) {
+			return false
+		}
+
+		n = n.next
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	n := l.head
+
+	for i := 0; i < l.len; i++ {
+		if less(v, n.value) {
+			if i == 0 {
+				l.Unshift(v)
+			} else {
+				l.insertNodeBefore(v, n, i)
+			}
+
+			return
+		}
+
+		n = n.next
+	}
+
+	l.Push(v)
+}
@@ -0,0 +1,886 @@
+package circularlist
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// singlyNode holds a single node of a singly linked circular list
+type singlyNode[T any] struct {
+	next  *singlyNode[T] // The node after this node in the list, or nil if the node was removed
+	value T              // The value stored with this node
+}
+
+// singlyIterator holds the iterator for a singly linked circular list
+type singlyIterator[T any] struct {
+	current *singlyNode[T] // The current node in traversal
+	list    *singlyList[T] // The list to which this iterator belongs
+}
+
+// Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
+func (iter *singlyIterator[T]) Next() GenericList.Iterator[T] {
+	if iter.current == nil || iter.list.len == 0 || iter.current == iter.list.last {
+		iter.current = nil
+		iter.list = nil
+
+		return nil
+	}
+
+	iter.current = iter.current.next
+
+	return iter
+}
+
+// Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
+func (iter *singlyIterator[T]) Previous() GenericList.Iterator[T] {
+	if iter.current == nil || iter.list.len == 0 || iter.current == iter.list.last.next {
+		iter.current = nil
+		iter.list = nil
+
+		return nil
+	}
+
+	iter.current = iter.list.findParentNode(iter.current)
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element
+func (iter *singlyIterator[T]) Get() T {
+	return iter.current.value
+}
+
+// Set sets the value of the iterator's current element
+func (iter *singlyIterator[T]) Set(v T) {
+	iter.current.value = v
+}
+
+// singlyCursor holds a cursor which loops forever over a singly linked circular list
+type singlyCursor[T any] struct {
+	list     *singlyList[T] // The list to which this cursor belongs
+	current  *singlyNode[T] // The current node, or nil if the cursor is not on an element
+	previous *singlyNode[T] // The node before the current node or before the cursor, or nil if it is not known
+}
+
+// Next moves the cursor to the next element, which is the first element after the last element, and returns its value and true, or false if the list is empty
+func (c *singlyCursor[T]) Next() (T, bool) {
+	if c.list.len == 0 {
+		return c.reset()
+	}
+
+	switch {
+	case c.current != nil && c.current.next != nil:
+		c.previous = c.current
+		c.current = c.current.next
+	case c.current == nil && c.previous != nil && c.previous.next != nil:
+		c.current = c.previous.next
+	default:
+		c.previous = c.list.last
+		c.current = c.list.last.next
+	}
+
+	return c.current.value, true
+}
+
+// Previous moves the cursor to the previous element, which is the last element before the first element, and returns its value and true, or false if the list is empty
+// Moving backwards has to search the previous node and is therefore linear in the list length.
+func (c *singlyCursor[T]) Previous() (T, bool) {
+	if c.list.len == 0 {
+		return c.reset()
+	}
+
+	switch {
+	case c.current != nil && c.current.next != nil:
+		c.current = c.parent()
+	case c.current == nil && c.previous != nil && c.previous.next != nil:
+		c.current = c.previous
+	default:
+		c.current = c.list.last
+	}
+
+	c.previous = nil
+
+	return c.current.value, true
+}
+
+// parent returns the node before the current node
+func (c *singlyCursor[T]) parent() *singlyNode[T] {
+	if c.previous != nil && c.previous.next == c.current {
+		return c.previous
+	}
+
+	return c.list.findParentNode(c.current)
+}
+
+// reset moves the cursor in front of the first element
+func (c *singlyCursor[T]) reset() (T, bool) {
+	c.current = nil
+	c.previous = nil
+
+	var v T
+
+	return v, false
+}
+
+// Get returns the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+func (c *singlyCursor[T]) Get() T {
+	return c.current.value
+}
+
+// Set sets the value of the cursor's current element, the cursor has to be moved with Next or Previous before
+func (c *singlyCursor[T]) Set(v T) {
+	c.current.value = v
+}
+
+// Remove removes the cursor's current element and returns its value and true, or false if there is no current element
+func (c *singlyCursor[T]) Remove() (T, bool) {
+	if c.current == nil || c.current.next == nil {
+		var v T
+
+		return v, false
+	}
+
+	c.previous = c.parent()
+
+	v := c.list.removeNodeAfter(c.previous, -1)
+
+	c.current = nil
+
+	return v, true
+}
+
+// singlyList holds a singly linked circular list
+type singlyList[T any] struct {
+	last *singlyNode[T] // The last node of the list, its next node is the first node of the list
+	len  int            // The current list length
+
+	finger      *singlyNode[T] // The last accessed node, or nil if there is none
+	fingerIndex int            // The index of the last accessed node
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// NewSingly returns a new singly linked circular list
+func NewSingly(opts ...Option[interface{}]) *singlyList[interface{}] {
+	return NewSinglyOf(opts...)
+}
+
+// NewSinglyOf returns a new singly linked circular list for values of type T
+func NewSinglyOf[T any](opts ...Option[T]) *singlyList[T] {
+	l := new(singlyList[T])
+
+	l.equal = newConfig(opts).equal
+
+	l.Clear()
+
+	return l
+}
+
+// Clear resets the list to zero elements and resets the list's meta data
+func (l *singlyList[T]) Clear() {
+	i := l.last
+
+	for ; l.len > 0; l.len-- {
+		j := i.next
+
+		i.next = nil
+
+		i = j
+	}
+
+	l.last = nil
+	l.len = 0
+
+	l.finger = nil
+}
+
+// Len returns the current list length
+func (l *singlyList[T]) Len() int {
+	return l.len
+}
+
+// Empty returns true if the current list length is zero
+func (l *singlyList[T]) Empty() bool {
+	return l.len == 0
+}
+
+// newNode returns a new node for the list
+func (l *singlyList[T]) newNode(v T) *singlyNode[T] {
+	return &singlyNode[T]{
+		value: v,
+	}
+}
+
+// findParentNode returns the node before the given node
+func (l *singlyList[T]) findParentNode(c *singlyNode[T]) *singlyNode[T] {
+	p := l.last
+
+	for i := 0; i < l.len; i++ {
+		if p.next == c {
+			return p
+		}
+
+		p = p.next
+	}
+
+	return nil
+}
+
+// getNode returns the node with the given index or nil, the index -1 returns the last node
+// The node is searched from the last accessed node if it is not behind the index, or else from the first node, and becomes the last accessed node.
+func (l *singlyList[T]) getNode(i int) (*singlyNode[T], error) {
+	if i < -1 || i >= l.len || l.len == 0 {
+		return nil, errors.New("index bounds out of range")
+	} else if i == -1 || i == l.len-1 {
+		return l.last, nil
+	}
+
+	n, j := l.last, -1
+
+	if l.finger != nil && l.fingerIndex <= i {
+		n, j = l.finger, l.fingerIndex
+	}
+
+	for ; j < i; j++ {
+		n = n.next
+	}
+
+	l.finger = n
+	l.fingerIndex = i
+
+	return n, nil
+}
+
+// insertNodeAfter creates a new node from a value, inserts it after a given node and returns the new one
+// The last node of the list is only changed if the list was empty. The index i of the new node keeps the last accessed node up to date.
+func (l *singlyList[T]) insertNodeAfter(v T, p *singlyNode[T], i int) *singlyNode[T] {
+	n := l.newNode(v)
+
+	if l.finger != nil && i <= l.fingerIndex {
+		l.fingerIndex++
+	}
+
+	if l.len == 0 {
+		n.next = n
+
+		l.last = n
+	} else {
+		n.next = p.next
+		p.next = n
+	}
+
+	l.len++
+
+	return n
+}
+
+// removeNodeAfter removes the node after the given node from the list
+// The index i of the removed node keeps the last accessed node up to date, it is forgotten if i is -1.
+func (l *singlyList[T]) removeNodeAfter(p *singlyNode[T], i int) T {
+	c := p.next
+
+	if c == l.finger || i < 0 {
+		l.finger = nil
+	} else if l.finger != nil && i < l.fingerIndex {
+		l.fingerIndex--
+	}
+
+	if l.len == 1 {
+		l.last = nil
+	} else {
+		p.next = c.next
+
+		if c == l.last {
+			l.last = p
+		}
+	}
+
+	c.next = nil
+
+	l.len--
+
+	return c.value
+}
+
+// newIterator returns a new iterator
+func (l *singlyList[T]) newIterator(current *singlyNode[T]) *singlyIterator[T] {
+	return &singlyIterator[T]{
+		current: current,
+		list:    l,
+	}
+}
+
+// Chan returns a channel which iterates from the front to the back of the list
+func (l *singlyList[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *singlyList[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *singlyList[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *singlyList[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for v := range l.Backward() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
+// The iterator stops at the back of the list, use Cursor to loop over the list forever.
+func (l *singlyList[T]) Iter() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(l.last.next)
+}
+
+// IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
+// The iterator stops at the front of the list and every Previous call is linear in the list length.
+func (l *singlyList[T]) IterBack() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(l.last)
+}
+
+// Cursor returns a cursor which loops forever over the list and which is positioned in front of the first element
+func (l *singlyList[T]) Cursor() Cursor[T] {
+	return &singlyCursor[T]{
+		list: l,
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the list
+func (l *singlyList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+// The values are taken from a copy of the list, since the nodes cannot be walked backwards.
+func (l *singlyList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := l.Slice()
+
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *singlyList[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
+// First returns the first value of the list and true, or false if there is no value
+func (l *singlyList[T]) First() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.last.next.value, true
+}
+
+// Last returns the last value of the list and true, or false if there is no value
+func (l *singlyList[T]) Last() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.last.value, true
+}
+
+// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) Get(i int) (T, error) {
+	if i < 0 {
+		var v T
+
+		return v, errors.New("index bounds out of range")
+	}
+
+	n, err := l.getNode(i)
+
+	if err != nil {
+		var v T
+
+		return v, err
+	}
+
+	return n.value, nil
+}
+
+// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+func (l *singlyList[T]) GetFunc(m func(v T) bool) (T, bool) {
+	for v := range l.All() {
+		if m(v) {
+			return v, true
+		}
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) Set(i int, v T) error {
+	if i < 0 {
+		return errors.New("index bounds out of range")
+	}
+
+	n, err := l.getNode(i)
+
+	if err != nil {
+		return err
+	}
+
+	n.value = v
+
+	return nil
+}
+
+// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+func (l *singlyList[T]) SetFunc(m func(v T) bool, v T) bool {
+	for it := l.Iter(); it != nil; it = it.Next() {
+		if m(it.Get()) {
+			it.Set(v)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Swap swaps the value of index i with the value of index j
+func (l *singlyList[T]) Swap(i, j int) {
+	if i < 0 || j < 0 {
+		return
+	}
+
+	ni, erri := l.getNode(i)
+	nj, errj := l.getNode(j)
+
+	if erri == nil && errj == nil {
+		ni.value, nj.value = nj.value, ni.value
+	}
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *singlyList[T]) Contains(v T) bool {
+	_, ok := l.IndexOf(v)
+
+	return ok
+}
+
+// IndexOf returns the first index of the given value and true, or false if it does not exists
+func (l *singlyList[T]) IndexOf(v T) (int, bool) {
+	for i, c := range l.Enumerate() {
+		if l.equal(c, v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+func (l *singlyList[T]) LastIndexOf(v T) (int, bool) {
+	j := -1
+
+	for i, c := range l.Enumerate() {
+		if l.equal(c, v) {
+			j = i
+		}
+	}
+
+	return j, j != -1
+}
+
+// Copy returns an exact copy of the list
+func (l *singlyList[T]) Copy() GenericList.List[T] {
+	n := NewSinglyOf(WithEqual(l.equal))
+
+	for v := range l.All() {
+		n.Push(v)
+	}
+
+	return n
+}
+
+// Slice returns a copy of the list as slice
+func (l *singlyList[T]) Slice() []T {
+	a := make([]T, 0, l.len)
+
+	for v := range l.All() {
+		a = append(a, v)
+	}
+
+	return a
+}
+
+// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) Insert(i int, v T) error {
+	if i < 0 || i > l.len {
+		return errors.New("index bounds out of range")
+	}
+
+	if i == l.len {
+		l.Push(v)
+	} else {
+		p, _ := l.getNode(i - 1)
+
+		l.insertNodeAfter(v, p, i)
+	}
+
+	return nil
+}
+
+// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= l.len {
+		var v T
+
+		return v, errors.New("index bounds out of range")
+	}
+
+	p, _ := l.getNode(i - 1)
+
+	return l.removeNodeAfter(p, i), nil
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *singlyList[T]) RemoveFirstOccurrence(v T) bool {
+	p := l.last
+
+	for i := 0; i < l.len; i++ {
+		if l.equal(p.next.value, v) {
+			l.removeNodeAfter(p, i)
+
+			return true
+		}
+
+		p = p.next
+	}
+
+	return false
+}
+
+// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *singlyList[T]) RemoveLastOccurrence(v T) bool {
+	var f *singlyNode[T]
+
+	j := 0
+
+	p := l.last
+
+	for i := 0; i < l.len; i++ {
+		if l.equal(p.next.value, v) {
+			f = p
+			j = i
+		}
+
+		p = p.next
+	}
+
+	if f == nil {
+		return false
+	}
+
+	l.removeNodeAfter(f, j)
+
+	return true
+}
+
+// RemoveEvery removes every k-th element going round the list until the list is empty and returns the removed values in the order of their removal, or nil if k is smaller than 1
+// Counting starts at the first element, so the last returned value is the survivor of the Josephus problem.
+func (l *singlyList[T]) RemoveEvery(k int) []T {
+	return removeEvery(l.Cursor(), l.len, k)
+}
+
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *singlyList[T]) Pop() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	p, _ := l.getNode(l.len - 2)
+
+	return l.removeNodeAfter(p, l.len-1), true
+}
+
+// Push inserts the given value at the end of the list
+func (l *singlyList[T]) Push(v T) {
+	l.last = l.insertNodeAfter(v, l.last, l.len)
+}
+
+// PushList pushes the given list
+func (l *singlyList[T]) PushList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Push(v)
+	}
+}
+
+// Shift removes and returns the first element and true, or false if there is no such element
+func (l *singlyList[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.removeNodeAfter(l.last, 0), true
+}
+
+// Unshift inserts the given value at the beginning of the list
+func (l *singlyList[T]) Unshift(v T) {
+	l.insertNodeAfter(v, l.last, 0)
+}
+
+// UnshiftList unshifts the given list
+func (l *singlyList[T]) UnshiftList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Unshift(v)
+	}
+}
+
+// Rotate rotates the list by k elements so that the element at index k becomes the first element, a negative k rotates in the other direction
+func (l *singlyList[T]) Rotate(k int) {
+	if l.len == 0 {
+		return
+	}
+
+	l.last, _ = l.getNode(rotation(k, l.len) - 1)
+
+	// the nodes are moved to other indices
+	l.finger = nil
+}
+
+// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *singlyList[T]) MoveAfter(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i-1 == m {
+		return nil
+	}
+
+	v, _ := l.Remove(i)
+
+	if i < m {
+		m--
+	}
+
+	l.Insert(m+1, v)
+
+	return nil
+}
+
+// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) MoveToBack(i int) error {
+	return l.MoveAfter(i, l.len-1)
+}
+
+// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *singlyList[T]) MoveBefore(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i == m-1 {
+		return nil
+	}
+
+	v, _ := l.Remove(i)
+
+	if i < m {
+		m--
+	}
+
+	l.Insert(m, v)
+
+	return nil
+}
+
+// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *singlyList[T]) MoveToFront(i int) error {
+	return l.MoveBefore(i, 0)
+}
+
+// mergeSort sorts the list by relinking its nodes with a stable bottom-up merge sort
+func (l *singlyList[T]) mergeSort(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// the nodes are moved to other indices
+	l.finger = nil
+
+	// the circle is opened for sorting and closed again afterwards
+	head := l.last.next
+	l.last.next = nil
+
+	for k := 1; k < l.len; k *= 2 {
+		var first, last *singlyNode[T]
+
+		p := head
+
+		for p != nil {
+			// the run starting at p and the run starting at q are merged, both have at most k nodes
+			q := p
+			ps := 0
+
+			for ps < k && q != nil {
+				q = q.next
+				ps++
+			}
+
+			qs := k
+
+			for ps > 0 || (qs > 0 && q != nil) {
+				var c *singlyNode[T]
+
+				// take the node of the first run if the values are equal to keep the sort stable
+				if qs == 0 || q == nil || (ps > 0 && !less(q.value, p.value)) {
+					c = p
+					p = p.next
+					ps--
+				} else {
+					c = q
+					q = q.next
+					qs--
+				}
+
+				if last == nil {
+					first = c
+				} else {
+					last.next = c
+				}
+
+				last = c
+			}
+
+			p = q
+		}
+
+		last.next = nil
+
+		head = first
+		l.last = last
+	}
+
+	l.last.next = head
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *singlyList[T]) Sort(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *singlyList[T]) SortStable(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *singlyList[T]) IsSorted(less func(a, b T) bool) bool {
+	if l.len == 0 {
+		return true
+	}
+
+	n := l.last.next
+
+	for i := 1; i < l.len; i++ {
+		if less(n.next.value, n.value) {
+			return false
+		}
+
+		n = n.next
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *singlyList[T]) InsertSorted(v T, less func(a, b T) bool) {
+	p := l.last
+
+	for i := 0; i < l.len; i++ {
+		if less(v, p.next.value) {
+			l.insertNodeAfter(v, p, i)
+
+			return
+		}
+
+		p = p.next
+	}
+
+	l.Push(v)
+}
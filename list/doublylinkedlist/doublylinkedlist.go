@@ -1,25 +1,27 @@
 package doublylinkedlist
 
 import (
+	"context"
 	"errors"
+	"iter"
 
-	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 // node holds a single node of a doubly linked list
-type node struct {
-	next     *node       // The node after this node in the list
-	previous *node       // The node before this node in the list
-	value    interface{} // The value stored with this node
+type node[T any] struct {
+	next     *node[T] // The node after this node in the list
+	previous *node[T] // The node before this node in the list
+	value    T        // The value stored with this node
 }
 
 // iterator holds the iterator for a doubly linked list
-type iterator struct {
-	current *node // The current node in traversal
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
 }
 
 // Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
-func (iter *iterator) Next() List.Iterator {
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
 	if iter.current != nil {
 		iter.current = iter.current.next
 	}
@@ -32,7 +34,7 @@ func (iter *iterator) Next() List.Iterator {
 }
 
 // Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
-func (iter *iterator) Previous() List.Iterator {
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
 	if iter.current != nil {
 		iter.current = iter.current.previous
 	}
@@ -45,25 +47,65 @@ func (iter *iterator) Previous() List.Iterator {
 }
 
 // Get returns the value of the iterator's current element
-func (iter *iterator) Get() interface{} {
+func (iter *iterator[T]) Get() T {
 	return iter.current.value
 }
 
 // Set sets the value of the iterator's current element
-func (iter *iterator) Set(v interface{}) {
+func (iter *iterator[T]) Set(v T) {
 	iter.current.value = v
 }
 
 // list holds a doubly linked list
-type list struct {
-	first *node // The first node of the list
-	last  *node // The last node of the list
-	len   int   // The current list length
+type list[T any] struct {
+	first *node[T] // The first node of the list
+	last  *node[T] // The last node of the list
+	len   int      // The current list length
+
+	finger      *node[T] // The last accessed node, or nil if there is none
+	fingerIndex int      // The index of the last accessed node
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// distance returns the distance between two indices
+func distance(i, j int) int {
+	if i < j {
+		return j - i
+	}
+
+	return i - j
 }
 
 // New returns a new doubly linked list
-func New() *list {
-	l := new(list)
+func New(opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new doubly linked list for values of type T
+func NewOf[T any](opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = equal[T]
+
+	for _, o := range opts {
+		o(l)
+	}
 
 	l.Clear()
 
@@ -71,7 +113,7 @@ func New() *list {
 }
 
 // Clear resets the list to zero elements and resets the list's meta data
-func (l *list) Clear() {
+func (l *list[T]) Clear() {
 	i := l.first
 
 	for i != nil {
@@ -86,46 +128,69 @@ func (l *list) Clear() {
 	l.first = nil
 	l.last = nil
 	l.len = 0
+
+	l.finger = nil
 }
 
 // Len returns the current list length
-func (l *list) Len() int {
+func (l *list[T]) Len() int {
 	return l.len
 }
 
 // Empty returns true if the current list length is zero
-func (l *list) Empty() bool {
+func (l *list[T]) Empty() bool {
 	return l.len == 0
 }
 
 // newNode returns a new node for the list
-func (l *list) newNode(v interface{}) *node {
-	return &node{
+func (l *list[T]) newNode(v T) *node[T] {
+	return &node[T]{
 		value: v,
 	}
 }
 
 // getNode returns the node with the given index or nil
-func (l *list) getNode(i int) (*node, error) {
-	if i > -1 && i < l.len {
-		j := 0
+// The node is searched from the first, the last or the last accessed node, whichever is the closest, and becomes the last accessed node.
+func (l *list[T]) getNode(i int) (*node[T], error) {
+	if i < 0 || i >= l.len {
+		return nil, errors.New("index bounds out of range")
+	}
 
-		for n := l.first; n != nil; n = n.next {
-			if i == j {
-				return n, nil
-			}
+	n, j := l.first, 0
 
-			j++
-		}
+	if l.len-1-i < i {
+		n, j = l.last, l.len-1
+	}
+
+	if l.finger != nil && distance(i, l.fingerIndex) < distance(i, j) {
+		n, j = l.finger, l.fingerIndex
+	}
+
+	for ; j < i; j++ {
+		n = n.next
+	}
+
+	for ; j > i; j-- {
+		n = n.previous
 	}
 
-	return nil, errors.New("index bounds out of range")
+	l.finger = n
+	l.fingerIndex = i
+
+	return n, nil
 }
 
 // insertNodeBefore creates a new node from a value, inserts it before a given node and returns the new one
-func (l *list) insertNodeBefore(v interface{}, p *node) *node {
+// The index i of the new node keeps the last accessed node up to date, it is forgotten if i is -1.
+func (l *list[T]) insertNodeBefore(v T, p *node[T], i int) *node[T] {
 	n := l.newNode(v)
 
+	if i < 0 {
+		l.finger = nil
+	} else if l.finger != nil && i <= l.fingerIndex {
+		l.fingerIndex++
+	}
+
 	if l.len == 0 {
 		l.first = n
 		l.last = n
@@ -149,9 +214,18 @@ func (l *list) insertNodeBefore(v interface{}, p *node) *node {
 }
 
 // remove removes a given node from the list
-func (l *list) removeNode(c *node) interface{} {
+// The index i of the node keeps the last accessed node up to date, it is forgotten if i is -1.
+func (l *list[T]) removeNode(c *node[T], i int) T {
 	if c == nil || l.len == 0 {
-		return nil
+		var v T
+
+		return v
+	}
+
+	if c == l.finger || i < 0 {
+		l.finger = nil
+	} else if l.finger != nil && i < l.fingerIndex {
+		l.fingerIndex--
 	}
 
 	if c == l.first {
@@ -185,44 +259,62 @@ func (l *list) removeNode(c *node) interface{} {
 }
 
 // newIterator returns a new iterator
-func (l *list) newIterator(current *node) *iterator {
-	return &iterator{
+func (l *list[T]) newIterator(current *node[T]) *iterator[T] {
+	return &iterator[T]{
 		current: current,
 	}
 }
 
 // Chan returns a channel which iterates from the front to the back of the list
-func (l *list) Chan(n int) <-chan interface{} {
-	ch := make(chan interface{})
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.Iter(); iter != nil; iter = iter.Next() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
-// ChanBack returns a channel which iterates from the back to the front of the list
-func (l *list) ChanBack(n int) <-chan interface{} {
-	ch := make(chan interface{})
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
 // Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
-func (l *list) Iter() List.Iterator {
+func (l *list[T]) Iter() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -231,7 +323,7 @@ func (l *list) Iter() List.Iterator {
 }
 
 // IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
-func (l *list) IterBack() List.Iterator {
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -239,48 +331,93 @@ func (l *list) IterBack() List.Iterator {
 	return l.newIterator(l.last)
 }
 
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
 // First returns the first value of the list and true, or false if there is no value
-func (l *list) First() (interface{}, bool) {
+func (l *list[T]) First() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.first.value, true
 }
 
 // Last returns the last value of the list and true, or false if there is no value
-func (l *list) Last() (interface{}, bool) {
+func (l *list[T]) Last() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.last.value, true
 }
 
 // Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Get(i int) (interface{}, error) {
+func (l *list[T]) Get(i int) (T, error) {
 	n, err := l.getNode(i)
 
 	if err != nil {
-		return nil, err
+		var v T
+
+		return v, err
 	}
 
 	return n.value, nil
 }
 
 // GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
-func (l *list) GetFunc(m func(v interface{}) bool) (interface{}, bool) {
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
 			return n.value, true
 		}
 	}
 
-	return nil, false
+	var v T
+
+	return v, false
 }
 
 // Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Set(i int, v interface{}) error {
+func (l *list[T]) Set(i int, v T) error {
 	n, err := l.getNode(i)
 
 	if err != nil {
@@ -293,7 +430,7 @@ func (l *list) Set(i int, v interface{}) error {
 }
 
 // SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
-func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
 			n.value = v
@@ -306,7 +443,7 @@ func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
 }
 
 // Swap swaps the value of index i with the value of index j
-func (l *list) Swap(i, j int) {
+func (l *list[T]) Swap(i, j int) {
 	ni, erri := l.getNode(i)
 	nj, errj := l.getNode(j)
 
@@ -316,18 +453,18 @@ func (l *list) Swap(i, j int) {
 }
 
 // Contains returns true if the value exists in the list, or false if it does not
-func (l *list) Contains(v interface{}) bool {
+func (l *list[T]) Contains(v T) bool {
 	_, ok := l.IndexOf(v)
 
 	return ok
 }
 
 // IndexOf returns the first index of the given value and true, or false if it does not exists
-func (l *list) IndexOf(v interface{}) (int, bool) {
+func (l *list[T]) IndexOf(v T) (int, bool) {
 	i := 0
 
 	for n := l.first; n != nil; n = n.next {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			return i, true
 		}
 
@@ -338,11 +475,11 @@ func (l *list) IndexOf(v interface{}) (int, bool) {
 }
 
 // LastIndexOf returns the last index of the given value and true, or false if it does not exists
-func (l *list) LastIndexOf(v interface{}) (int, bool) {
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
 	i := l.len - 1
 
 	for n := l.last; n != nil; n = n.previous {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			return i, true
 		}
 
@@ -353,8 +490,8 @@ func (l *list) LastIndexOf(v interface{}) (int, bool) {
 }
 
 // Copy returns an exact copy of the list
-func (l *list) Copy() List.List {
-	n := New()
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(WithEqual(l.equal))
 
 	for i := l.first; i != nil; i = i.next {
 		n.Push(i.value)
@@ -364,8 +501,8 @@ func (l *list) Copy() List.List {
 }
 
 // Slice returns a copy of the list as slice
-func (l *list) Slice() []interface{} {
-	a := make([]interface{}, l.len)
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
 
 	j := 0
 
@@ -379,7 +516,7 @@ func (l *list) Slice() []interface{} {
 }
 
 // Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Insert(i int, v interface{}) error {
+func (l *list[T]) Insert(i int, v T) error {
 	if i < 0 || i > l.len {
 		return errors.New("index bounds out of range")
 	}
@@ -391,58 +528,81 @@ func (l *list) Insert(i int, v interface{}) error {
 	} else {
 		p, _ := l.getNode(i)
 
-		l.insertNodeBefore(v, p)
+		l.insertNodeBefore(v, p, i)
 	}
 
 	return nil
 }
 
 // Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Remove(i int) (interface{}, error) {
+func (l *list[T]) Remove(i int) (T, error) {
 	if i < 0 || i >= l.len {
-		return nil, errors.New("index bounds out of range")
+		var v T
+
+		return v, errors.New("index bounds out of range")
 	}
 
 	c, _ := l.getNode(i)
+	n := c.next
 
-	return l.removeNode(c), nil
+	v := l.removeNode(c, i)
+
+	// the next node moves to the index of the removed node and becomes the last accessed node
+	if n != nil {
+		l.finger = n
+		l.fingerIndex = i
+	}
+
+	return v, nil
 }
 
 // RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveFirstOccurrence(v interface{}) bool {
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	j := 0
+
 	for i := l.first; i != nil; i = i.next {
-		if i.value == v {
-			l.removeNode(i)
+		if l.equal(i.value, v) {
+			l.removeNode(i, j)
 
 			return true
 		}
+
+		j++
 	}
 
 	return false
 }
 
 // RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveLastOccurrence(v interface{}) bool {
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	j := l.len - 1
+
 	for i := l.last; i != nil; i = i.previous {
-		if i.value == v {
-			l.removeNode(i)
+		if l.equal(i.value, v) {
+			l.removeNode(i, j)
 
 			return true
 		}
+
+		j--
 	}
 
 	return false
 }
 
 // Pop removes and returns the last element and true, or false if there is no such element
-func (l *list) Pop() (interface{}, bool) {
-	r := l.removeNode(l.last)
+func (l *list[T]) Pop() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
 
-	return r, r != nil
+	return l.removeNode(l.last, l.len-1), true
 }
 
 // Push inserts the given value at the end of the list
-func (l *list) Push(v interface{}) {
+func (l *list[T]) Push(v T) {
 	n := l.newNode(v)
 
 	if l.len == 0 {
@@ -458,33 +618,37 @@ func (l *list) Push(v interface{}) {
 }
 
 // PushList pushes the given list
-func (l *list) PushList(l2 List.List) {
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Push(iter.Get())
 	}
 }
 
 // Shift removes and returns the first element and true, or false if there is no such element
-func (l *list) Shift() (interface{}, bool) {
-	r := l.removeNode(l.first)
+func (l *list[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
 
-	return r, r != nil
+	return l.removeNode(l.first, 0), true
 }
 
 // Unshift inserts the given value at the beginning of the list
-func (l *list) Unshift(v interface{}) {
-	l.insertNodeBefore(v, l.first)
+func (l *list[T]) Unshift(v T) {
+	l.insertNodeBefore(v, l.first, 0)
 }
 
 // UnshiftList unshifts the given list
-func (l *list) UnshiftList(l2 List.List) {
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Unshift(iter.Get())
 	}
 }
 
 // MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveAfter(i, m int) error {
+func (l *list[T]) MoveAfter(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -507,12 +671,12 @@ func (l *list) MoveAfter(i, m int) error {
 }
 
 // MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToBack(i int) error {
+func (l *list[T]) MoveToBack(i int) error {
 	return l.MoveAfter(i, l.len-1)
 }
 
 // MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveBefore(i, m int) error {
+func (l *list[T]) MoveBefore(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -535,6 +699,112 @@ func (l *list) MoveBefore(i, m int) error {
 }
 
 // MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToFront(i int) error {
+func (l *list[T]) MoveToFront(i int) error {
 	return l.MoveBefore(i, 0)
 }
+
+// mergeSort sorts the list by relinking its nodes with a stable bottom-up merge sort
+func (l *list[T]) mergeSort(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// the nodes are moved to other indices
+	l.finger = nil
+
+	for k := 1; k < l.len; k *= 2 {
+		var first, last *node[T]
+
+		p := l.first
+
+		for p != nil {
+			// the run starting at p and the run starting at q are merged, both have at most k nodes
+			q := p
+			ps := 0
+
+			for ps < k && q != nil {
+				q = q.next
+				ps++
+			}
+
+			qs := k
+
+			for ps > 0 || (qs > 0 && q != nil) {
+				var c *node[T]
+
+				// take the node of the first run if the values are equal to keep the sort stable
+				if qs == 0 || q == nil || (ps > 0 && !less(q.value, p.value)) {
+					c = p
+					p = p.next
+					ps--
+				} else {
+					c = q
+					q = q.next
+					qs--
+				}
+
+				if last == nil {
+					first = c
+				} else {
+					last.next = c
+				}
+
+				last = c
+			}
+
+			p = q
+		}
+
+		last.next = nil
+
+		l.first = first
+		l.last = last
+	}
+
+	// only the next links are sorted, so the previous links have to be restored
+	var p *node[T]
+
+	for c := l.first; c != nil; c = c.next {
+		c.previous = p
+
+		p = c
+	}
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	for c := l.first; c != nil && c.next != nil; c = c.next {
+		if less(c.next.value, c.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	c := l.first
+	i := 0
+
+	for c != nil && !less(v, c.value) {
+		c = c.next
+		i++
+	}
+
+	if c == nil {
+		l.Push(v)
+	} else {
+		l.insertNodeBefore(v, c, i)
+	}
+}
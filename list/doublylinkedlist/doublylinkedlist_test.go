@@ -1,9 +1,14 @@
 package doublylinkedlist
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 
+	. "github.com/zimmski/container/test/assert"
+
 	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 func TestRunAllTests(t *testing.T) {
@@ -11,11 +16,149 @@ func TestRunAllTests(t *testing.T) {
 		New: func(t *testing.T) List.List {
 			return New()
 		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf[int]()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(WithEqual(equal))
+		},
 	}
 
 	lt.Run(t)
 }
 
+func TestFinger(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	l := NewOf[int]()
+	var s []int
+
+	checkFinger := func() {
+		if l.finger != nil {
+			n := l.first
+
+			for j := 0; j < l.fingerIndex; j++ {
+				n = n.next
+			}
+
+			True(t, n == l.finger)
+		}
+	}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(9) {
+		case 0, 1:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Get(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+			}
+		case 2:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				Nil(t, l.Set(j, v))
+				s[j] = v
+			}
+		case 3:
+			j := r.Intn(len(s) + 1)
+
+			Nil(t, l.Insert(j, v))
+			s = append(s[:j], append([]int{v}, s[j:]...)...)
+		case 4:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Remove(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+
+				s = append(s[:j], s[j+1:]...)
+			}
+		case 5:
+			if r.Intn(2) == 0 {
+				l.Push(v)
+				s = append(s, v)
+			} else {
+				l.Unshift(v)
+				s = append([]int{v}, s...)
+			}
+		case 6:
+			if r.Intn(2) == 0 {
+				if w, ok := l.Pop(); ok {
+					Equal(t, w, s[len(s)-1])
+
+					s = s[:len(s)-1]
+				}
+			} else if w, ok := l.Shift(); ok {
+				Equal(t, w, s[0])
+
+				s = s[1:]
+			}
+		case 7:
+			k := slices.Index(s, v)
+
+			if r.Intn(2) == 0 {
+				Equal(t, l.RemoveFirstOccurrence(v), k != -1)
+			} else {
+				for j, w := range s {
+					if w == v {
+						k = j
+					}
+				}
+
+				Equal(t, l.RemoveLastOccurrence(v), k != -1)
+			}
+
+			if k != -1 {
+				s = append(s[:k], s[k+1:]...)
+			}
+		case 8:
+			less := func(a, b int) bool {
+				return a < b
+			}
+
+			if r.Intn(10) == 0 {
+				l.Sort(less)
+				slices.Sort(s)
+			} else {
+				l.InsertSorted(v, less)
+
+				j := slices.IndexFunc(s, func(w int) bool {
+					return v < w
+				})
+				if j == -1 {
+					j = len(s)
+				}
+
+				s = append(s[:j], append([]int{v}, s[j:]...)...)
+			}
+		}
+
+		checkFinger()
+
+		Equal(t, l.Len(), len(s))
+
+		if i%50 == 0 {
+			Equal(t, l.Slice(), append([]int{}, s...))
+		}
+	}
+}
+
 func BenchmarkPushSequentiel(b *testing.B) {
 	lb := &List.ListBenchmark{
 		New: func(b *testing.B) List.List {
@@ -35,3 +178,53 @@ func BenchmarkUnshiftSequentiel(b *testing.B) {
 
 	lb.BenchmarkUnshiftSequentiel(b)
 }
+
+func BenchmarkPushShiftSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkPushShiftSequentiel(b)
+}
+
+func BenchmarkGetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetSequentiel(b)
+}
+
+func BenchmarkGetBackwardSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetBackwardSequentiel(b)
+}
+
+func BenchmarkSetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkSetSequentiel(b)
+}
+
+func BenchmarkInsertRemoveSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkInsertRemoveSequentiel(b)
+}
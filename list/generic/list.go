@@ -0,0 +1,118 @@
+package generic
+
+import (
+	"context"
+	"iter"
+)
+
+// Iterator defines a list iterator over values of type T
+type Iterator[T any] interface {
+	// Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
+	Next() Iterator[T]
+	// Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
+	Previous() Iterator[T]
+
+	// Get returns the value of the iterator's current element
+	Get() T
+	// Set sets the value of the iterator's current element
+	Set(v T)
+}
+
+// List defines a list holding values of type T
+type List[T any] interface {
+	// Clear resets the list to zero elements and resets the list's meta data
+	Clear()
+	// Len returns the current list length
+	Len() int
+	// Empty returns true if the current list length is zero
+	Empty() bool
+
+	// Chan returns a channel which iterates from the front to the back of the list
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+	Chan(n int) <-chan T
+	// ChanBack returns a channel which iterates from the back to the front of the list
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+	ChanBack(n int) <-chan T
+	// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan T
+	// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan T
+
+	// Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
+	Iter() Iterator[T]
+	// IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
+	IterBack() Iterator[T]
+
+	// All returns a sequence which iterates from the front to the back of the list
+	All() iter.Seq[T]
+	// Backward returns a sequence which iterates from the back to the front of the list
+	Backward() iter.Seq[T]
+	// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+	Enumerate() iter.Seq2[int, T]
+
+	// First returns the first value of the list and true, or false if there is no value
+	First() (T, bool)
+	// Last returns the last value of the list and true, or false if there is no value
+	Last() (T, bool)
+	// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+	Get(i int) (T, error)
+	// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+	GetFunc(m func(v T) bool) (T, bool)
+	// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+	Set(i int, v T) error
+	// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+	SetFunc(m func(v T) bool, v T) bool
+	// Swap swaps the value of index i with the value of index j
+	Swap(i, j int)
+
+	// Contains returns true if the value exists in the list, or false if it does not
+	Contains(v T) bool
+	// IndexOf returns the first index of the given value and true, or false if it does not exists
+	IndexOf(v T) (int, bool)
+	// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+	LastIndexOf(v T) (int, bool)
+
+	// Copy returns an exact copy of the list
+	Copy() List[T]
+	// Slice returns a copy of the list as a slice
+	Slice() []T
+
+	// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+	Insert(i int, v T) error
+	// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+	Remove(i int) (T, error)
+	// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+	RemoveFirstOccurrence(v T) bool
+	// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+	RemoveLastOccurrence(v T) bool
+	// Pop removes and returns the last element and true, or false if there is no such element
+	Pop() (T, bool)
+	// Push inserts the given value at the end of the list
+	Push(v T)
+	// PushList pushes the given list
+	PushList(l2 List[T])
+	// Shift removes and returns the first element and true, or false if there is no such element
+	Shift() (T, bool)
+	// Unshift inserts the given value at the beginning of the list
+	Unshift(v T)
+	// UnshiftList unshifts the given list
+	UnshiftList(l2 List[T])
+
+	// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+	MoveAfter(i, m int) error
+	// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+	MoveToBack(i int) error
+	// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+	MoveBefore(i, m int) error
+	// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+	MoveToFront(i int) error
+
+	// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+	Sort(less func(a, b T) bool)
+	// SortStable sorts the list in place with the given less function and keeps the order of equal values
+	SortStable(less func(a, b T) bool)
+	// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+	IsSorted(less func(a, b T) bool) bool
+	// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+	InsertSorted(v T, less func(a, b T) bool)
+}
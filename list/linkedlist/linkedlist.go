@@ -1,31 +1,46 @@
 package linkedlist
 
 import (
+	"context"
 	"errors"
+	"iter"
+	"math"
 
-	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 // node holds a single node of a single linked list
-type node struct {
-	next  *node       // The node after this node in the list
-	value interface{} // The value stored with this node
+type node[T any] struct {
+	next  *node[T] // The node after this node in the list
+	value T        // The value stored with this node
 }
 
 // iterator holds the iterator for a single linked list
-type iterator struct {
-	current *node // The current node in traversal
-	list    *list // The list to which this iterator belongs
+// Since nodes have no link to their parent, the iterator remembers the nodes before the current node for iterating backwards. It keeps at most a few blocks of about the square root of the list length, so that a complete backward iteration is linear in time without keeping a pointer to every node. Remembered nodes are checked before they are used, since the list can be modified while iterating.
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	list    *list[T] // The list to which this iterator belongs
+
+	parents     []*node[T] // Consecutive nodes before the current node, the last one is the parent of the current node
+	checkpoints []*node[T] // Nodes before the parents from which a block of parents can be refilled, the last one is the closest to the current node
+	block       int        // The length of one block of parents
 }
 
 // Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
-func (iter *iterator) Next() List.Iterator {
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
 	if iter.current != nil {
+		// parents are only remembered if the iterator has already been iterating backwards
+		if len(iter.parents) > 0 || len(iter.checkpoints) > 0 {
+			iter.pushParent(iter.current)
+		}
+
 		iter.current = iter.current.next
 	}
 
 	if iter.current == nil {
 		iter.list = nil
+		iter.parents = nil
+		iter.checkpoints = nil
 
 		return nil
 	}
@@ -34,13 +49,15 @@ func (iter *iterator) Next() List.Iterator {
 }
 
 // Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
-func (iter *iterator) Previous() List.Iterator {
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
 	if iter.current != nil {
-		iter.current = iter.list.findParentNode(iter.current)
+		iter.current = iter.popParent()
 	}
 
 	if iter.current == nil {
 		iter.list = nil
+		iter.parents = nil
+		iter.checkpoints = nil
 
 		return nil
 	}
@@ -48,26 +65,144 @@ func (iter *iterator) Previous() List.Iterator {
 	return iter
 }
 
+// pushParent remembers the given node as parent of the next current node
+func (iter *iterator[T]) pushParent(n *node[T]) {
+	// the oldest block of parents is replaced by a checkpoint to bound the remembered nodes
+	if len(iter.parents) >= 2*iter.block {
+		iter.checkpoints = append(iter.checkpoints, iter.parents[0])
+		iter.parents = append(iter.parents[:0], iter.parents[iter.block:]...)
+	}
+
+	iter.parents = append(iter.parents, n)
+}
+
+// popParent returns the parent of the current node and forgets it, or nil if the current node has no parent
+func (iter *iterator[T]) popParent() *node[T] {
+	if len(iter.parents) == 0 {
+		iter.fillParents()
+
+		if len(iter.parents) == 0 {
+			return nil
+		}
+	}
+
+	p := iter.parents[len(iter.parents)-1]
+	iter.parents = iter.parents[:len(iter.parents)-1]
+
+	// the list was modified since the parent was remembered, so the parents are collected again from the first node
+	if p.next != iter.current {
+		iter.parents = iter.parents[:0]
+		iter.checkpoints = iter.checkpoints[:0]
+
+		iter.fillParents()
+
+		if len(iter.parents) == 0 {
+			return nil
+		}
+
+		p = iter.parents[len(iter.parents)-1]
+		iter.parents = iter.parents[:len(iter.parents)-1]
+	}
+
+	return p
+}
+
+// fillParents refills the parents with the block before the current node
+func (iter *iterator[T]) fillParents() {
+	// the checkpoints are set by walking once from the first node to the current node
+	if len(iter.checkpoints) == 0 {
+		iter.block = max(1, int(math.Sqrt(float64(iter.list.len))))
+
+		i := 0
+		n := iter.list.first
+
+		for ; n != nil && n != iter.current; n = n.next {
+			if i%iter.block == 0 {
+				iter.checkpoints = append(iter.checkpoints, n)
+			}
+
+			i++
+		}
+
+		// the current node is not part of the list anymore
+		if n == nil {
+			iter.checkpoints = iter.checkpoints[:0]
+		}
+
+		if len(iter.checkpoints) == 0 {
+			return
+		}
+	}
+
+	c := iter.checkpoints[len(iter.checkpoints)-1]
+	iter.checkpoints = iter.checkpoints[:len(iter.checkpoints)-1]
+
+	n := c
+
+	for ; n != nil && n != iter.current; n = n.next {
+		iter.parents = append(iter.parents, n)
+	}
+
+	// the checkpoint was removed from the list, so the checkpoints are collected again from the first node
+	if n == nil {
+		iter.parents = iter.parents[:0]
+		iter.checkpoints = iter.checkpoints[:0]
+
+		iter.fillParents()
+	}
+}
+
 // Get returns the value of the iterator's current element
-func (iter *iterator) Get() interface{} {
+func (iter *iterator[T]) Get() T {
 	return iter.current.value
 }
 
 // Set sets the value of the iterator's current element
-func (iter *iterator) Set(v interface{}) {
+func (iter *iterator[T]) Set(v T) {
 	iter.current.value = v
 }
 
 // list holds a single linked list
-type list struct {
-	first *node // The first node of the list
-	last  *node // The last node of the list
-	len   int   // The current list length
+type list[T any] struct {
+	first *node[T] // The first node of the list
+	last  *node[T] // The last node of the list
+	len   int      // The current list length
+
+	finger      *node[T] // The last accessed node, or nil if there is none
+	fingerIndex int      // The index of the last accessed node
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
 }
 
 // New returns a new single linked list
-func New() *list {
-	l := new(list)
+func New(opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new single linked list for values of type T
+func NewOf[T any](opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = equal[T]
+
+	for _, o := range opts {
+		o(l)
+	}
 
 	l.Clear()
 
@@ -75,7 +210,7 @@ func New() *list {
 }
 
 // Clear resets the list to zero elements and resets the list's meta data
-func (l *list) Clear() {
+func (l *list[T]) Clear() {
 	i := l.first
 
 	for i != nil {
@@ -89,29 +224,31 @@ func (l *list) Clear() {
 	l.first = nil
 	l.last = nil
 	l.len = 0
+
+	l.finger = nil
 }
 
 // Len returns the current list length
-func (l *list) Len() int {
+func (l *list[T]) Len() int {
 	return l.len
 }
 
 // Empty returns true if the current list length is zero
-func (l *list) Empty() bool {
+func (l *list[T]) Empty() bool {
 	return l.len == 0
 }
 
 // newNode returns a new node for the list
-func (l *list) newNode(v interface{}) *node {
-	return &node{
+func (l *list[T]) newNode(v T) *node[T] {
+	return &node[T]{
 		value: v,
 	}
 }
 
 // findParentNode returns the parent to a given node or nil
-func (l *list) findParentNode(c *node) *node {
+func (l *list[T]) findParentNode(c *node[T]) *node[T] {
 	if c != nil {
-		var p *node
+		var p *node[T]
 
 		for i := l.first; i != nil; i = i.next {
 			if i == c {
@@ -126,40 +263,49 @@ func (l *list) findParentNode(c *node) *node {
 }
 
 // getNode returns the node with the given index or nil
-func (l *list) getNode(i int) (*node, error) {
-	if i > -1 && i < l.len {
-		j := 0
+// The node is searched from the last accessed node if it is not behind the index, or else from the first node, and becomes the last accessed node.
+func (l *list[T]) getNode(i int) (*node[T], error) {
+	if i < 0 || i >= l.len {
+		return nil, errors.New("index bounds out of range")
+	}
 
-		for n := l.first; n != nil; n = n.next {
-			if i == j {
-				return n, nil
-			}
+	n, j := l.first, 0
 
-			j++
-		}
+	if i == l.len-1 {
+		n, j = l.last, l.len-1
+	} else if l.finger != nil && l.fingerIndex <= i {
+		n, j = l.finger, l.fingerIndex
 	}
 
-	return nil, errors.New("index bounds out of range")
+	for ; j < i; j++ {
+		n = n.next
+	}
+
+	l.finger = n
+	l.fingerIndex = i
+
+	return n, nil
 }
 
-// insertNodeBefore creates a new node from a value, inserts it before a given node and returns the new one
-func (l *list) insertNodeBefore(v interface{}, p *node) *node {
+// insertNodeAfter creates a new node from a value, inserts it after a given node or at the front of the list if the given node is nil and returns the new one
+// The index i of the new node keeps the last accessed node up to date.
+func (l *list[T]) insertNodeAfter(v T, p *node[T], i int) *node[T] {
 	n := l.newNode(v)
 
-	// insert first node
+	if l.finger != nil && i <= l.fingerIndex {
+		l.fingerIndex++
+	}
+
 	if p == nil {
+		n.next = l.first
 		l.first = n
-		l.last = n
 	} else {
-		if p == l.first {
-			l.first = n
-		} else {
-			pp := l.findParentNode(p)
-
-			pp.next = n
-		}
+		n.next = p.next
+		p.next = n
+	}
 
-		n.next = p
+	if p == l.last {
+		l.last = n
 	}
 
 	l.len++
@@ -168,9 +314,18 @@ func (l *list) insertNodeBefore(v interface{}, p *node) *node {
 }
 
 // remove removes a given node from the list using the provided parent p
-func (l *list) removeNode(c *node, p *node) interface{} {
+// The index i of the node keeps the last accessed node up to date, it is forgotten if i is -1.
+func (l *list[T]) removeNode(c *node[T], p *node[T], i int) T {
 	if c == nil || l.len == 0 {
-		return nil
+		var v T
+
+		return v
+	}
+
+	if c == l.finger || i < 0 {
+		l.finger = nil
+	} else if l.finger != nil && i < l.fingerIndex {
+		l.fingerIndex--
 	}
 
 	if c == l.first {
@@ -200,45 +355,63 @@ func (l *list) removeNode(c *node, p *node) interface{} {
 }
 
 // newIterator returns a new iterator
-func (l *list) newIterator(current *node) *iterator {
-	return &iterator{
+func (l *list[T]) newIterator(current *node[T]) *iterator[T] {
+	return &iterator[T]{
 		current: current,
 		list:    l,
 	}
 }
 
 // Chan returns a channel which iterates from the front to the back of the list
-func (l *list) Chan(n int) <-chan interface{} {
-	ch := make(chan interface{})
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.Iter(); iter != nil; iter = iter.Next() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
-// ChanBack returns a channel which iterates from the back to the front of the list
-func (l *list) ChanBack(n int) <-chan interface{} {
-	ch := make(chan interface{})
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
 // Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
-func (l *list) Iter() List.Iterator {
+func (l *list[T]) Iter() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -247,7 +420,7 @@ func (l *list) Iter() List.Iterator {
 }
 
 // IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
-func (l *list) IterBack() List.Iterator {
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -255,48 +428,93 @@ func (l *list) IterBack() List.Iterator {
 	return l.newIterator(l.last)
 }
 
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
 // First returns the first value of the list and true, or false if there is no value
-func (l *list) First() (interface{}, bool) {
+func (l *list[T]) First() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.first.value, true
 }
 
 // Last returns the last value of the list and true, or false if there is no value
-func (l *list) Last() (interface{}, bool) {
+func (l *list[T]) Last() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.last.value, true
 }
 
 // Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Get(i int) (interface{}, error) {
+func (l *list[T]) Get(i int) (T, error) {
 	n, err := l.getNode(i)
 
 	if err != nil {
-		return nil, err
+		var v T
+
+		return v, err
 	}
 
 	return n.value, nil
 }
 
 // GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
-func (l *list) GetFunc(m func(v interface{}) bool) (interface{}, bool) {
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
 			return n.value, true
 		}
 	}
 
-	return nil, false
+	var v T
+
+	return v, false
 }
 
 // Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Set(i int, v interface{}) error {
+func (l *list[T]) Set(i int, v T) error {
 	n, err := l.getNode(i)
 
 	if err != nil {
@@ -309,7 +527,7 @@ func (l *list) Set(i int, v interface{}) error {
 }
 
 // SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
-func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
 			n.value = v
@@ -322,7 +540,7 @@ func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
 }
 
 // Swap swaps the value of index i with the value of index j
-func (l *list) Swap(i, j int) {
+func (l *list[T]) Swap(i, j int) {
 	ni, erri := l.getNode(i)
 	nj, errj := l.getNode(j)
 
@@ -332,18 +550,18 @@ func (l *list) Swap(i, j int) {
 }
 
 // Contains returns true if the value exists in the list, or false if it does not
-func (l *list) Contains(v interface{}) bool {
+func (l *list[T]) Contains(v T) bool {
 	_, ok := l.IndexOf(v)
 
 	return ok
 }
 
 // IndexOf returns the first index of the given value and true, or false if it does not exists
-func (l *list) IndexOf(v interface{}) (int, bool) {
+func (l *list[T]) IndexOf(v T) (int, bool) {
 	i := 0
 
 	for n := l.first; n != nil; n = n.next {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			return i, true
 		}
 
@@ -354,12 +572,12 @@ func (l *list) IndexOf(v interface{}) (int, bool) {
 }
 
 // LastIndexOf returns the last index of the given value and true, or false if it does not exists
-func (l *list) LastIndexOf(v interface{}) (int, bool) {
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
 	i := 0
 	j := -1
 
 	for n := l.first; n != nil; n = n.next {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			j = i
 		}
 
@@ -370,8 +588,8 @@ func (l *list) LastIndexOf(v interface{}) (int, bool) {
 }
 
 // Copy returns an exact copy of the list
-func (l *list) Copy() List.List {
-	n := New()
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(WithEqual(l.equal))
 
 	for i := l.first; i != nil; i = i.next {
 		n.Push(i.value)
@@ -381,8 +599,8 @@ func (l *list) Copy() List.List {
 }
 
 // Slice returns a copy of the list as slice
-func (l *list) Slice() []interface{} {
-	a := make([]interface{}, l.len)
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
 
 	j := 0
 
@@ -396,7 +614,7 @@ func (l *list) Slice() []interface{} {
 }
 
 // Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Insert(i int, v interface{}) error {
+func (l *list[T]) Insert(i int, v T) error {
 	if i < 0 || i > l.len {
 		return errors.New("index bounds out of range")
 	}
@@ -406,60 +624,69 @@ func (l *list) Insert(i int, v interface{}) error {
 	} else if i == l.len {
 		l.Push(v)
 	} else {
-		p, _ := l.getNode(i)
+		p, _ := l.getNode(i - 1)
 
-		l.insertNodeBefore(v, p)
+		l.insertNodeAfter(v, p, i)
 	}
 
 	return nil
 }
 
 // Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Remove(i int) (interface{}, error) {
+func (l *list[T]) Remove(i int) (T, error) {
 	switch {
 	case i < 0 || i >= l.len:
-		return nil, errors.New("index bounds out of range")
+		var v T
+
+		return v, errors.New("index bounds out of range")
 	case i == 0:
-		return l.removeNode(l.first, nil), nil
+		return l.removeNode(l.first, nil, 0), nil
 	default:
 		p, _ := l.getNode(i - 1)
 
-		return l.removeNode(p.next, p), nil
+		return l.removeNode(p.next, p, i), nil
 	}
 }
 
 // RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveFirstOccurrence(v interface{}) bool {
-	var p *node
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	var p *node[T]
+
+	j := 0
 
 	for i := l.first; i != nil; i = i.next {
-		if i.value == v {
-			l.removeNode(i, p)
+		if l.equal(i.value, v) {
+			l.removeNode(i, p, j)
 
 			return true
 		}
 
 		p = i
+		j++
 	}
 
 	return false
 }
 
 // RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveLastOccurrence(v interface{}) bool {
-	var c, p, pp *node
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	var c, p, pp *node[T]
+
+	j, k := 0, 0
 
 	for i := l.first; i != nil; i = i.next {
-		if i.value == v {
+		if l.equal(i.value, v) {
 			c = i
 			p = pp
+			k = j
 		}
 
 		pp = i
+		j++
 	}
 
 	if c != nil {
-		l.removeNode(c, p)
+		l.removeNode(c, p, k)
 
 		return true
 	}
@@ -468,14 +695,18 @@ func (l *list) RemoveLastOccurrence(v interface{}) bool {
 }
 
 // Pop removes and returns the last element and true, or false if there is no such element
-func (l *list) Pop() (interface{}, bool) {
-	r := l.removeNode(l.last, nil)
+func (l *list[T]) Pop() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
 
-	return r, r != nil
+	return l.removeNode(l.last, nil, l.len-1), true
 }
 
 // Push inserts the given value at the end of the list
-func (l *list) Push(v interface{}) {
+func (l *list[T]) Push(v T) {
 	n := l.newNode(v)
 
 	if l.len == 0 {
@@ -490,33 +721,37 @@ func (l *list) Push(v interface{}) {
 }
 
 // PushList pushes the given list
-func (l *list) PushList(l2 List.List) {
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Push(iter.Get())
 	}
 }
 
 // Shift removes and returns the first element and true, or false if there is no such element
-func (l *list) Shift() (interface{}, bool) {
-	r := l.removeNode(l.first, nil)
+func (l *list[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
 
-	return r, r != nil
+		return v, false
+	}
+
+	return l.removeNode(l.first, nil, 0), true
 }
 
 // Unshift inserts the given value at the beginning of the list
-func (l *list) Unshift(v interface{}) {
-	l.insertNodeBefore(v, l.first)
+func (l *list[T]) Unshift(v T) {
+	l.insertNodeAfter(v, nil, 0)
 }
 
 // UnshiftList unshifts the given list
-func (l *list) UnshiftList(l2 List.List) {
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Unshift(iter.Get())
 	}
 }
 
 // MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveAfter(i, m int) error {
+func (l *list[T]) MoveAfter(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -539,12 +774,12 @@ func (l *list) MoveAfter(i, m int) error {
 }
 
 // MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToBack(i int) error {
+func (l *list[T]) MoveToBack(i int) error {
 	return l.MoveAfter(i, l.len-1)
 }
 
 // MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveBefore(i, m int) error {
+func (l *list[T]) MoveBefore(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -567,6 +802,106 @@ func (l *list) MoveBefore(i, m int) error {
 }
 
 // MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToFront(i int) error {
+func (l *list[T]) MoveToFront(i int) error {
 	return l.MoveBefore(i, 0)
 }
+
+// mergeSort sorts the list by relinking its nodes with a stable bottom-up merge sort
+func (l *list[T]) mergeSort(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// the nodes are moved to other indices
+	l.finger = nil
+
+	for k := 1; k < l.len; k *= 2 {
+		var first, last *node[T]
+
+		p := l.first
+
+		for p != nil {
+			// the run starting at p and the run starting at q are merged, both have at most k nodes
+			q := p
+			ps := 0
+
+			for ps < k && q != nil {
+				q = q.next
+				ps++
+			}
+
+			qs := k
+
+			for ps > 0 || (qs > 0 && q != nil) {
+				var c *node[T]
+
+				// take the node of the first run if the values are equal to keep the sort stable
+				if qs == 0 || q == nil || (ps > 0 && !less(q.value, p.value)) {
+					c = p
+					p = p.next
+					ps--
+				} else {
+					c = q
+					q = q.next
+					qs--
+				}
+
+				if last == nil {
+					first = c
+				} else {
+					last.next = c
+				}
+
+				last = c
+			}
+
+			p = q
+		}
+
+		last.next = nil
+
+		l.first = first
+		l.last = last
+	}
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	for c := l.first; c != nil && c.next != nil; c = c.next {
+		if less(c.next.value, c.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	var p *node[T]
+
+	c := l.first
+	i := 0
+
+	for c != nil && !less(v, c.value) {
+		p = c
+		c = c.next
+		i++
+	}
+
+	if c == nil {
+		l.Push(v)
+	} else {
+		l.insertNodeAfter(v, p, i)
+	}
+}
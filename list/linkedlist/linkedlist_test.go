@@ -1,11 +1,15 @@
 package linkedlist
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 
 	. "github.com/zimmski/container/test/assert"
 
 	List "github.com/zimmski/container/list"
+	dll "github.com/zimmski/container/list/doublylinkedlist"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 func TestRunAllTests(t *testing.T) {
@@ -13,6 +17,22 @@ func TestRunAllTests(t *testing.T) {
 		New: func(t *testing.T) List.List {
 			return New()
 		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf[int]()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(WithEqual(equal))
+		},
 	}
 
 	lt.Run(t)
@@ -33,11 +53,238 @@ func TestFindParentNode(t *testing.T) {
 	Nil(t, l.findParentNode(nil))
 
 	// not existing node
-	n := &node{}
+	n := &node[interface{}]{}
 
 	Nil(t, l.findParentNode(n))
 }
 
+func TestIteratorPrevious(t *testing.T) {
+	l := NewOf[int]()
+
+	for i := 0; i < 100; i++ {
+		l.Push(i)
+	}
+
+	// a backward iteration visits every node once
+	var vs []int
+
+	for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
+		vs = append(vs, iter.Get())
+	}
+
+	Equal(t, len(vs), 100)
+
+	for i, v := range vs {
+		Equal(t, v, 99-i)
+	}
+
+	// random walks keep the position and remember only a bounded number of nodes
+	r := rand.New(rand.NewSource(1))
+
+	it := l.newIterator(l.last)
+	i := 99
+
+	for j := 0; j < 5000; j++ {
+		if r.Intn(2) == 0 && i > 0 {
+			Equal(t, it.Previous(), GenericList.Iterator[int](it))
+
+			i--
+		} else if i < 99 {
+			Equal(t, it.Next(), GenericList.Iterator[int](it))
+
+			i++
+		}
+
+		Equal(t, it.Get(), i)
+
+		b := max(1, it.block)
+
+		True(t, len(it.parents) <= 2*b)
+		True(t, len(it.checkpoints) <= 100/b+1)
+	}
+
+	// the iteration ends at the front of the list
+	it = l.newIterator(l.first.next)
+
+	Equal(t, it.Previous().Get(), 0)
+	Nil(t, it.Previous())
+
+	// removed nodes have no parent
+	it = l.newIterator(l.last)
+
+	l.Pop()
+
+	Nil(t, it.Previous())
+
+	// modifications of the list are seen by iterators which already remember parents
+	for _, modify := range []func(l GenericList.List[int]){
+		func(l GenericList.List[int]) {
+			_, _ = l.Remove(7)
+		},
+		func(l GenericList.List[int]) {
+			_ = l.Insert(8, 100)
+		},
+		func(l GenericList.List[int]) {
+			_, _ = l.Remove(0)
+			l.Unshift(101)
+		},
+		func(l GenericList.List[int]) {
+			_, _ = l.Remove(2)
+			_, _ = l.Remove(3)
+			_ = l.Insert(1, 102)
+		},
+	} {
+		for _, n := range []int{10, 100} {
+			l := NewOf[int]()
+			d := dll.NewOf[int]()
+
+			for i := 0; i < n; i++ {
+				l.Push(i)
+				d.Push(i)
+			}
+
+			it := l.IterBack().Previous()
+			dt := d.IterBack().Previous()
+
+			modify(l)
+			modify(d)
+
+			var vs, ds []int
+
+			for ; it != nil; it = it.Previous() {
+				vs = append(vs, it.Get())
+			}
+			for ; dt != nil; dt = dt.Previous() {
+				ds = append(ds, dt.Get())
+			}
+
+			Equal(t, vs, ds)
+		}
+	}
+}
+
+func TestFinger(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	l := NewOf[int]()
+	var s []int
+
+	checkFinger := func() {
+		if l.finger != nil {
+			n := l.first
+
+			for j := 0; j < l.fingerIndex; j++ {
+				n = n.next
+			}
+
+			True(t, n == l.finger)
+		}
+	}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(9) {
+		case 0, 1:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Get(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+			}
+		case 2:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				Nil(t, l.Set(j, v))
+				s[j] = v
+			}
+		case 3:
+			j := r.Intn(len(s) + 1)
+
+			Nil(t, l.Insert(j, v))
+			s = append(s[:j], append([]int{v}, s[j:]...)...)
+		case 4:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Remove(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+
+				s = append(s[:j], s[j+1:]...)
+			}
+		case 5:
+			if r.Intn(2) == 0 {
+				l.Push(v)
+				s = append(s, v)
+			} else {
+				l.Unshift(v)
+				s = append([]int{v}, s...)
+			}
+		case 6:
+			if r.Intn(2) == 0 {
+				if w, ok := l.Pop(); ok {
+					Equal(t, w, s[len(s)-1])
+
+					s = s[:len(s)-1]
+				}
+			} else if w, ok := l.Shift(); ok {
+				Equal(t, w, s[0])
+
+				s = s[1:]
+			}
+		case 7:
+			k := slices.Index(s, v)
+
+			if r.Intn(2) == 0 {
+				Equal(t, l.RemoveFirstOccurrence(v), k != -1)
+			} else {
+				for j, w := range s {
+					if w == v {
+						k = j
+					}
+				}
+
+				Equal(t, l.RemoveLastOccurrence(v), k != -1)
+			}
+
+			if k != -1 {
+				s = append(s[:k], s[k+1:]...)
+			}
+		case 8:
+			less := func(a, b int) bool {
+				return a < b
+			}
+
+			if r.Intn(10) == 0 {
+				l.Sort(less)
+				slices.Sort(s)
+			} else {
+				l.InsertSorted(v, less)
+
+				j := slices.IndexFunc(s, func(w int) bool {
+					return v < w
+				})
+				if j == -1 {
+					j = len(s)
+				}
+
+				s = append(s[:j], append([]int{v}, s[j:]...)...)
+			}
+		}
+
+		checkFinger()
+
+		Equal(t, l.Len(), len(s))
+
+		if i%50 == 0 {
+			Equal(t, l.Slice(), append([]int{}, s...))
+		}
+	}
+}
+
 func BenchmarkPushSequentiel(b *testing.B) {
 	lb := &List.ListBenchmark{
 		New: func(b *testing.B) List.List {
@@ -57,3 +304,83 @@ func BenchmarkUnshiftSequentiel(b *testing.B) {
 
 	lb.BenchmarkUnshiftSequentiel(b)
 }
+
+func BenchmarkPushShiftSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkPushShiftSequentiel(b)
+}
+
+func BenchmarkGetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetSequentiel(b)
+}
+
+func BenchmarkIterBackSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkIterBackSequentiel(b)
+}
+
+func BenchmarkLastIndexOfSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkLastIndexOfSequentiel(b)
+}
+
+func BenchmarkRemoveLastOccurrenceSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkRemoveLastOccurrenceSequentiel(b)
+}
+
+func BenchmarkGetBackwardSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkGetBackwardSequentiel(b)
+}
+
+func BenchmarkSetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkSetSequentiel(b)
+}
+
+func BenchmarkInsertRemoveSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.BenchmarkInsertRemoveSequentiel(b)
+}
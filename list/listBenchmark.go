@@ -10,6 +10,14 @@ type ListBenchmark struct {
 	New func(b *testing.B) List
 }
 
+// Run executes the basic list benchmarks as sub benchmarks
+func (lb *ListBenchmark) Run(b *testing.B) {
+	b.Run("PushSequentiel", lb.BenchmarkPushSequentiel)
+	b.Run("UnshiftSequentiel", lb.BenchmarkUnshiftSequentiel)
+	b.Run("PushShiftSequentiel", lb.BenchmarkPushShiftSequentiel)
+	b.Run("GetSequentiel", lb.BenchmarkGetSequentiel)
+}
+
 func (lb *ListBenchmark) BenchmarkPushSequentiel(b *testing.B) {
 	l := lb.New(b)
 
@@ -33,3 +41,135 @@ func (lb *ListBenchmark) BenchmarkUnshiftSequentiel(b *testing.B) {
 		l.Unshift(i)
 	}
 }
+
+func (lb *ListBenchmark) BenchmarkPushShiftSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Push(i)
+		l.Shift()
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkGetSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Get(i % 1000)
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkIterBackSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
+		}
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkLastIndexOfSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.LastIndexOf(i % 1000)
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkRemoveLastOccurrenceSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.RemoveLastOccurrence(i % 1000)
+		l.Push(i % 1000)
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkGetBackwardSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Get(999 - i%1000)
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkSetSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Set(i%1000, i)
+	}
+}
+
+func (lb *ListBenchmark) BenchmarkInsertRemoveSequentiel(b *testing.B) {
+	l := lb.New(b)
+
+	for i := 0; i < 1000; i++ {
+		l.Push(i)
+	}
+
+	debug.FreeOSMemory()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Insert(i%1000, i)
+		l.Remove(i%1000 + 1)
+	}
+}
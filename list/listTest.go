@@ -1,6 +1,9 @@
 package list
 
 import (
+	"context"
+	"math/rand"
+	"slices"
 	"testing"
 
 	. "github.com/zimmski/container/test/assert"
@@ -16,6 +19,8 @@ var VLen = len(V)
 // ListTest is the base for all tests of lists
 type ListTest struct {
 	New func(t *testing.T) List
+	// NewEqual returns a new list which compares values with the given function, the equality tests are skipped if it is nil
+	NewEqual func(t *testing.T, equal func(a, b interface{}) bool) List
 }
 
 // Run executes all basic list tests
@@ -26,10 +31,17 @@ func (lt *ListTest) Run(t *testing.T) {
 		lt.TestBasic(t)
 		lt.TestIterator(t)
 		lt.TestChannels(t)
+		lt.TestChannelsContext(t)
+		lt.TestSeq(t)
 		lt.TestSlice(t)
 		lt.TestInserts(t)
 		lt.TestRemove(t)
 		lt.TestRemoveOccurrence(t)
+
+		if lt.NewEqual != nil {
+			lt.TestEqual(t)
+		}
+
 		lt.TestClear(t)
 		lt.TestCopy(t)
 		lt.TestIndexOf(t)
@@ -38,6 +50,7 @@ func (lt *ListTest) Run(t *testing.T) {
 		lt.TestFuncs(t)
 		lt.TestSwap(t)
 		lt.TestMoves(t)
+		lt.TestSort(t)
 
 		lt.TestLeaks(t)
 	}))
@@ -313,6 +326,181 @@ func (lt *ListTest) TestChannels(t *testing.T) {
 	Equal(t, i, -1)
 }
 
+// TestChannelsContext tests buffered and cancellable list channels
+func (lt *ListTest) TestChannelsContext(t *testing.T) {
+	l := lt.NewFilledList(t)
+
+	// buffered channels
+	ch := l.Chan(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i := 0
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	ch = l.ChanBack(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i = VLen - 1
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// channels with a context which is never done
+	i = 0
+
+	for v := range l.ChanContext(context.Background(), 0) {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	i = VLen - 1
+
+	for v := range l.ChanBackContext(context.Background(), 0) {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// consumers which stop early
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := l.ChanContext(ctx, 0)
+		Equal(t, <-ch, V[0])
+
+		chBack := l.ChanBackContext(ctx, 1)
+		Equal(t, <-chBack, V[VLen-1])
+
+		cancel()
+
+		// wait until the goroutines noticed the cancellation and closed the channels
+		i := 0
+
+		for range ch {
+			i++
+		}
+		for range chBack {
+			i++
+		}
+
+		True(t, i < 2*VLen-3, "goroutines did not stop after the cancellation")
+	}))
+
+	// contexts which are already done
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for range l.ChanContext(ctx, 0) {
+		}
+		for range l.ChanBackContext(ctx, 0) {
+		}
+	}))
+}
+
+// TestSeq tests list sequences
+func (lt *ListTest) TestSeq(t *testing.T) {
+	// empty sequences
+	l := lt.New(t)
+
+	Equal(t, len(slices.Collect(l.All())), 0)
+	Equal(t, len(slices.Collect(l.Backward())), 0)
+
+	for range l.Enumerate() {
+		Fail(t, "empty list yielded a value")
+	}
+
+	// full sequences
+	l = lt.NewFilledList(t)
+
+	Equal(t, slices.Collect(l.All()), V)
+
+	r := slices.Clone(V)
+	slices.Reverse(r)
+	Equal(t, slices.Collect(l.Backward()), r)
+
+	i := 0
+
+	for j, v := range l.Enumerate() {
+		Equal(t, j, i)
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	// sequences stop early
+	i = 0
+
+	for v := range l.All() {
+		Equal(t, v, V[i])
+
+		if i == 2 {
+			break
+		}
+
+		i++
+	}
+
+	Equal(t, i, 2)
+
+	i = VLen - 1
+
+	for v := range l.Backward() {
+		Equal(t, v, V[i])
+
+		if i == VLen-3 {
+			break
+		}
+
+		i--
+	}
+
+	Equal(t, i, VLen-3)
+
+	i = 0
+
+	for j, v := range l.Enumerate() {
+		Equal(t, j, i)
+		Equal(t, v, V[i])
+
+		if j == 3 {
+			break
+		}
+
+		i++
+	}
+
+	Equal(t, i, 3)
+
+	// sequences can be reused and see modifications of the list
+	s := l.All()
+
+	Equal(t, slices.Collect(s), V)
+
+	l.Push(V[0])
+
+	Equal(t, slices.Collect(s), append(slices.Clone(V), V[0]))
+}
+
 // TestSlice tests converting the list to slice
 func (lt *ListTest) TestSlice(t *testing.T) {
 	l := lt.New(t)
@@ -476,6 +664,84 @@ func (lt *ListTest) TestRemoveOccurrence(t *testing.T) {
 	Equal(t, l.Slice(), []interface{}{1, 1})
 }
 
+// TestEqual tests lookups and removals with a custom equality function
+func (lt *ListTest) TestEqual(t *testing.T) {
+	type item struct {
+		id   int
+		name string
+	}
+
+	// structs are matched by their id
+	l := lt.NewEqual(t, func(a, b interface{}) bool {
+		return a.(item).id == b.(item).id
+	})
+
+	l.Push(item{1, "a"})
+	l.Push(item{2, "b"})
+	l.Push(item{1, "c"})
+
+	True(t, l.Contains(item{id: 2}))
+	False(t, l.Contains(item{id: 3}))
+
+	i, ok := l.IndexOf(item{id: 1})
+	True(t, ok)
+	Equal(t, i, 0)
+	i, ok = l.LastIndexOf(item{id: 1})
+	True(t, ok)
+	Equal(t, i, 2)
+	i, ok = l.IndexOf(item{id: 3})
+	False(t, ok)
+	Equal(t, i, -1)
+	i, ok = l.LastIndexOf(item{id: 3})
+	False(t, ok)
+	Equal(t, i, -1)
+
+	True(t, l.RemoveLastOccurrence(item{id: 1}))
+	Equal(t, l.Slice(), []interface{}{item{1, "a"}, item{2, "b"}})
+	True(t, l.RemoveFirstOccurrence(item{id: 2}))
+	Equal(t, l.Slice(), []interface{}{item{1, "a"}})
+	False(t, l.RemoveFirstOccurrence(item{id: 2}))
+	False(t, l.RemoveLastOccurrence(item{id: 3}))
+	Equal(t, l.Slice(), []interface{}{item{1, "a"}})
+
+	// copies keep the equality function
+	c := l.Copy()
+
+	True(t, c.Contains(item{id: 1}))
+	True(t, c.RemoveFirstOccurrence(item{id: 1}))
+	Equal(t, c.Len(), 0)
+	Equal(t, l.Len(), 1)
+
+	// non-comparable values
+	l = lt.NewEqual(t, func(a, b interface{}) bool {
+		return slices.Equal(a.([]int), b.([]int))
+	})
+
+	l.Push([]int{1, 2})
+	l.Push([]int{3})
+	l.Push([]int{1, 2})
+
+	True(t, l.Contains([]int{3}))
+	False(t, l.Contains([]int{4}))
+
+	i, ok = l.IndexOf([]int{1, 2})
+	True(t, ok)
+	Equal(t, i, 0)
+	i, ok = l.LastIndexOf([]int{1, 2})
+	True(t, ok)
+	Equal(t, i, 2)
+
+	True(t, l.RemoveFirstOccurrence([]int{1, 2}))
+	Equal(t, l.Slice(), []interface{}{[]int{3}, []int{1, 2}})
+	True(t, l.RemoveLastOccurrence([]int{1, 2}))
+	Equal(t, l.Slice(), []interface{}{[]int{3}})
+	False(t, l.RemoveLastOccurrence([]int{1, 2}))
+
+	c = l.Copy()
+
+	True(t, c.Contains([]int{3}))
+}
+
 // TestClear tests clearing the list
 func (lt *ListTest) TestClear(t *testing.T) {
 	l := lt.NewFilledList(t)
@@ -796,6 +1062,173 @@ func (lt *ListTest) TestMoves(t *testing.T) {
 	Equal(t, l.Len(), ll+1)
 }
 
+// TestSort tests sorting lists
+func (lt *ListTest) TestSort(t *testing.T) {
+	less := func(a, b interface{}) bool {
+		return a.(int) < b.(int)
+	}
+	// values are only compared by their tens digit to check the stability
+	lessTens := func(a, b interface{}) bool {
+		return a.(int)/10 < b.(int)/10
+	}
+	toList := func(s []int) []interface{} {
+		r := make([]interface{}, len(s))
+
+		for i, v := range s {
+			r[i] = v
+		}
+
+		return r
+	}
+	checkLinks := func(l List, expected []int) {
+		Equal(t, l.Len(), len(expected))
+		Equal(t, l.Slice(), toList(expected))
+
+		r := slices.Clone(expected)
+		slices.Reverse(r)
+		b := make([]interface{}, 0, l.Len())
+
+		for v := range l.Backward() {
+			b = append(b, v)
+		}
+
+		Equal(t, b, toList(r))
+
+		if len(expected) != 0 {
+			v, ok := l.First()
+			True(t, ok)
+			Equal(t, v, expected[0])
+			v, ok = l.Last()
+			True(t, ok)
+			Equal(t, v, expected[len(expected)-1])
+		}
+	}
+
+	for _, sort := range []func(l List, less func(a, b interface{}) bool){
+		func(l List, less func(a, b interface{}) bool) {
+			l.Sort(less)
+		},
+		func(l List, less func(a, b interface{}) bool) {
+			l.SortStable(less)
+		},
+	} {
+		// empty list
+		l := lt.New(t)
+
+		True(t, l.IsSorted(less))
+		sort(l, less)
+		checkLinks(l, []int{})
+
+		// one element
+		l.Push(1)
+
+		True(t, l.IsSorted(less))
+		sort(l, less)
+		checkLinks(l, []int{1})
+
+		// sorted and reversed lists
+		for _, n := range []int{2, 3, 10, 100} {
+			var expected []int
+
+			l = lt.New(t)
+
+			for i := 0; i < n; i++ {
+				l.Push(i)
+
+				expected = append(expected, i)
+			}
+
+			True(t, l.IsSorted(less))
+			sort(l, less)
+			checkLinks(l, expected)
+
+			l = lt.New(t)
+
+			for i := n - 1; i > -1; i-- {
+				l.Push(i)
+			}
+
+			False(t, l.IsSorted(less))
+			sort(l, less)
+			True(t, l.IsSorted(less))
+			checkLinks(l, expected)
+		}
+
+		// random values with duplicates
+		r := rand.New(rand.NewSource(1))
+
+		for _, n := range []int{5, 17, 64, 257} {
+			var expected []int
+
+			l = lt.New(t)
+
+			for i := 0; i < n; i++ {
+				v := r.Intn(n / 2)
+
+				l.Push(v)
+
+				expected = append(expected, v)
+			}
+
+			slices.Sort(expected)
+
+			sort(l, less)
+			True(t, l.IsSorted(less))
+			checkLinks(l, expected)
+
+			// the list is still usable after sorting
+			l.Push(n)
+			l.Unshift(-1)
+			_, err := l.Remove(n / 2)
+			Nil(t, err)
+			Equal(t, l.Len(), n+1)
+		}
+	}
+
+	// stable sort
+	l := lt.New(t)
+
+	for _, v := range []int{31, 12, 35, 10, 33, 14, 21, 37, 11} {
+		l.Push(v)
+	}
+
+	False(t, l.IsSorted(lessTens))
+	l.SortStable(lessTens)
+	True(t, l.IsSorted(lessTens))
+	False(t, l.IsSorted(less))
+	checkLinks(l, []int{12, 10, 14, 11, 21, 31, 35, 33, 37})
+
+	// sorted inserts
+	l = lt.New(t)
+
+	for _, v := range []int{31, 12, 35, 10, 33, 14, 21, 37, 11} {
+		l.InsertSorted(v, lessTens)
+
+		True(t, l.IsSorted(lessTens))
+	}
+
+	checkLinks(l, []int{12, 10, 14, 11, 21, 31, 35, 33, 37})
+
+	r := rand.New(rand.NewSource(2))
+
+	var expected []int
+
+	l = lt.New(t)
+
+	for i := 0; i < 200; i++ {
+		v := r.Intn(50)
+
+		l.InsertSorted(v, less)
+
+		expected = append(expected, v)
+	}
+
+	slices.Sort(expected)
+
+	True(t, l.IsSorted(less))
+	checkLinks(l, expected)
+}
+
 // TestLeaks test for leaks
 func (lt *ListTest) TestLeaks(t *testing.T) {
 	l := lt.New(t)
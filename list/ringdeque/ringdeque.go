@@ -0,0 +1,649 @@
+package ringdeque
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// minCapacity is the capacity of the ring buffer after the first value is inserted
+const minCapacity = 8
+
+// iterator holds the iterator for a ring deque
+type iterator[T any] struct {
+	list *list[T] // The list of this iterator
+	i    int      // The current index in the list
+}
+
+// Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
+	iter.i++
+
+	if iter.i < 0 || iter.i >= iter.list.len {
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
+	iter.i--
+
+	if iter.i < 0 || iter.i >= iter.list.len {
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element
+func (iter *iterator[T]) Get() T {
+	return iter.list.values[iter.list.index(iter.i)]
+}
+
+// Set sets the value of the iterator's current element
+func (iter *iterator[T]) Set(v T) {
+	iter.list.values[iter.list.index(iter.i)] = v
+}
+
+// list holds a double-ended queue which is stored in a ring buffer
+// The capacity of the ring buffer is always a power of two so that indices wrap around with a bit mask. The buffer grows when it is full and shrinks when it is less than a quarter full.
+type list[T any] struct {
+	values []T // The ring buffer
+	first  int // The index of the first value in the ring buffer
+	len    int // The current list length
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// New returns a new ring deque
+func New(opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(opts...)
+}
+
+// NewOf returns a new ring deque for values of type T
+func NewOf[T any](opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = equal[T]
+
+	for _, o := range opts {
+		o(l)
+	}
+
+	l.Clear()
+
+	return l
+}
+
+// Clear resets the list to zero elements and resets the list's meta data
+func (l *list[T]) Clear() {
+	l.values = nil
+	l.first = 0
+	l.len = 0
+}
+
+// Len returns the current list length
+func (l *list[T]) Len() int {
+	return l.len
+}
+
+// Empty returns true if the current list length is zero
+func (l *list[T]) Empty() bool {
+	return l.len == 0
+}
+
+// index returns the index in the ring buffer for the given list index
+func (l *list[T]) index(i int) int {
+	return (l.first + i) & (len(l.values) - 1)
+}
+
+// resize moves all values into a new ring buffer with the given capacity which starts at index 0
+func (l *list[T]) resize(c int) {
+	values := make([]T, c)
+
+	if l.len != 0 {
+		if l.first+l.len <= len(l.values) {
+			copy(values, l.values[l.first:l.first+l.len])
+		} else {
+			n := copy(values, l.values[l.first:])
+			copy(values[n:], l.values[:l.len-n])
+		}
+	}
+
+	l.values = values
+	l.first = 0
+}
+
+// grow doubles the capacity of the ring buffer if it is full
+func (l *list[T]) grow() {
+	if l.len == len(l.values) {
+		l.resize(max(minCapacity, 2*len(l.values)))
+	}
+}
+
+// shrink halves the capacity of the ring buffer if it is less than a quarter full
+func (l *list[T]) shrink() {
+	if len(l.values) > minCapacity && l.len < len(l.values)/4 {
+		l.resize(len(l.values) / 2)
+	}
+}
+
+// newIterator returns a new iterator
+func (l *list[T]) newIterator(i int) *iterator[T] {
+	return &iterator[T]{
+		list: l,
+		i:    i,
+	}
+}
+
+// Chan returns a channel which iterates from the front to the back of the list
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
+func (l *list[T]) Iter() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(0)
+}
+
+// IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.newIterator(l.len - 1)
+}
+
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < l.len; i++ {
+			if !yield(l.values[l.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := l.len - 1; i > -1; i-- {
+			if !yield(l.values[l.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < l.len; i++ {
+			if !yield(i, l.values[l.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the list and true, or false if there is no value
+func (l *list[T]) First() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.values[l.first], true
+}
+
+// Last returns the last value of the list and true, or false if there is no value
+func (l *list[T]) Last() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return l.values[l.index(l.len-1)], true
+}
+
+// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Get(i int) (T, error) {
+	if i < 0 || i >= l.len {
+		var v T
+
+		return v, errors.New("index bounds out of range")
+	}
+
+	return l.values[l.index(i)], nil
+}
+
+// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
+	for i := 0; i < l.len; i++ {
+		if v := l.values[l.index(i)]; m(v) {
+			return v, true
+		}
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Set(i int, v T) error {
+	if i < 0 || i >= l.len {
+		return errors.New("index bounds out of range")
+	}
+
+	l.values[l.index(i)] = v
+
+	return nil
+}
+
+// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
+	for i := 0; i < l.len; i++ {
+		if j := l.index(i); m(l.values[j]) {
+			l.values[j] = v
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Swap swaps the value of index i with the value of index j
+func (l *list[T]) Swap(i, j int) {
+	if i < 0 || i >= l.len || j < 0 || j >= l.len {
+		return
+	}
+
+	i, j = l.index(i), l.index(j)
+
+	l.values[i], l.values[j] = l.values[j], l.values[i]
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *list[T]) Contains(v T) bool {
+	_, ok := l.IndexOf(v)
+
+	return ok
+}
+
+// IndexOf returns the first index of the given value and true, or false if it does not exists
+func (l *list[T]) IndexOf(v T) (int, bool) {
+	for i := 0; i < l.len; i++ {
+		if l.equal(l.values[l.index(i)], v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
+	for i := l.len - 1; i > -1; i-- {
+		if l.equal(l.values[l.index(i)], v) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// Copy returns an exact copy of the list
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(WithEqual(l.equal))
+
+	n.values = make([]T, len(l.values))
+	n.len = l.len
+
+	copy(n.values, l.values)
+	n.first = l.first
+
+	return n
+}
+
+// Slice returns a copy of the list as slice
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
+
+	for i := range a {
+		a[i] = l.values[l.index(i)]
+	}
+
+	return a
+}
+
+// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+// The values on the shorter side of the index are moved which needs at most n/2 moves.
+func (l *list[T]) Insert(i int, v T) error {
+	if i < 0 || i > l.len {
+		return errors.New("index bounds out of range")
+	}
+
+	l.grow()
+
+	if i < l.len/2 {
+		l.first = l.index(-1)
+
+		for j := 0; j < i; j++ {
+			l.values[l.index(j)] = l.values[l.index(j+1)]
+		}
+	} else {
+		for j := l.len; j > i; j-- {
+			l.values[l.index(j)] = l.values[l.index(j-1)]
+		}
+	}
+
+	l.values[l.index(i)] = v
+
+	l.len++
+
+	return nil
+}
+
+// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+// The values on the shorter side of the index are moved which needs at most n/2 moves.
+func (l *list[T]) Remove(i int) (T, error) {
+	var v T
+
+	if i < 0 || i >= l.len {
+		return v, errors.New("index bounds out of range")
+	}
+
+	v = l.values[l.index(i)]
+
+	var zero T
+
+	if i < l.len/2 {
+		for j := i; j > 0; j-- {
+			l.values[l.index(j)] = l.values[l.index(j-1)]
+		}
+
+		l.values[l.first] = zero
+		l.first = l.index(1)
+	} else {
+		for j := i; j < l.len-1; j++ {
+			l.values[l.index(j)] = l.values[l.index(j+1)]
+		}
+
+		l.values[l.index(l.len-1)] = zero
+	}
+
+	l.len--
+
+	l.shrink()
+
+	return v, nil
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	i, ok := l.IndexOf(v)
+	if ok {
+		l.Remove(i)
+	}
+
+	return ok
+}
+
+// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	i, ok := l.LastIndexOf(v)
+	if ok {
+		l.Remove(i)
+	}
+
+	return ok
+}
+
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *list[T]) Pop() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	v, _ := l.Remove(l.len - 1)
+
+	return v, true
+}
+
+// Push inserts the given value at the end of the list
+func (l *list[T]) Push(v T) {
+	l.grow()
+
+	l.values[l.index(l.len)] = v
+
+	l.len++
+}
+
+// PushList pushes the given list
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Push(v)
+	}
+}
+
+// Shift removes and returns the first element and true, or false if there is no such element
+func (l *list[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	v, _ := l.Remove(0)
+
+	return v, true
+}
+
+// Unshift inserts the given value at the beginning of the list
+func (l *list[T]) Unshift(v T) {
+	l.grow()
+
+	l.first = l.index(-1)
+	l.values[l.first] = v
+
+	l.len++
+}
+
+// UnshiftList unshifts the given list
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
+	for _, v := range l2.Slice() {
+		l.Unshift(v)
+	}
+}
+
+// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveAfter(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i-1 == m {
+		return nil
+	}
+
+	l.move(i, m+1)
+
+	return nil
+}
+
+// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToBack(i int) error {
+	return l.MoveAfter(i, l.len-1)
+}
+
+// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveBefore(i, m int) error {
+	if i < 0 || i >= l.len {
+		return errors.New("i bounds out of range")
+	} else if m < 0 || m >= l.len {
+		return errors.New("m bounds out of range")
+	}
+
+	if i == m || i == m-1 {
+		return nil
+	}
+
+	l.move(i, m)
+
+	return nil
+}
+
+// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToFront(i int) error {
+	return l.MoveBefore(i, 0)
+}
+
+// move moves the element at index i before the element which is at index m before the move by shifting the values in between
+func (l *list[T]) move(i, m int) {
+	v := l.values[l.index(i)]
+
+	if i < m {
+		for j := i; j < m-1; j++ {
+			l.values[l.index(j)] = l.values[l.index(j+1)]
+		}
+
+		l.values[l.index(m-1)] = v
+	} else {
+		for j := i; j > m; j-- {
+			l.values[l.index(j)] = l.values[l.index(j-1)]
+		}
+
+		l.values[l.index(m)] = v
+	}
+}
+
+// compareFunc returns a compare function for the given less function
+func compareFunc[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// linear returns the values of the list as one slice of the ring buffer, the ring buffer is rearranged if the values wrap around
+func (l *list[T]) linear() []T {
+	if l.first+l.len > len(l.values) {
+		l.resize(len(l.values))
+	}
+
+	return l.values[l.first : l.first+l.len]
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	slices.SortFunc(l.linear(), compareFunc(less))
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	slices.SortStableFunc(l.linear(), compareFunc(less))
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	for i := 1; i < l.len; i++ {
+		if less(l.values[l.index(i)], l.values[l.index(i-1)]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	i := 0
+
+	for i < l.len && !less(v, l.values[l.index(i)]) {
+		i++
+	}
+
+	l.Insert(i, v)
+}
@@ -0,0 +1,83 @@
+package ringdeque
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+func TestRunAllTests(t *testing.T) {
+	lt := &List.ListTest{
+		New: func(t *testing.T) List.List {
+			return New()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf[int]()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestCapacity(t *testing.T) {
+	l := NewOf[int]()
+
+	Equal(t, len(l.values), 0)
+
+	// the ring buffer grows by powers of two
+	for i := 0; i < 100; i++ {
+		l.Push(i)
+	}
+
+	Equal(t, len(l.values), 128)
+
+	// the ring buffer shrinks if it is less than a quarter full
+	for i := 0; i < 90; i++ {
+		v, ok := l.Shift()
+		True(t, ok)
+		Equal(t, v, i)
+	}
+
+	Equal(t, len(l.values), 32)
+	Equal(t, l.Slice(), []int{90, 91, 92, 93, 94, 95, 96, 97, 98, 99})
+
+	// values which wrap around the end of the ring buffer are kept in order
+	for i := 0; i < 20; i++ {
+		l.Unshift(-i)
+		l.Pop()
+	}
+
+	Equal(t, l.Slice(), []int{-19, -18, -17, -16, -15, -14, -13, -12, -11, -10})
+
+	l.Sort(func(a, b int) bool {
+		return a > b
+	})
+
+	Equal(t, l.Slice(), []int{-10, -11, -12, -13, -14, -15, -16, -17, -18, -19})
+}
+
+func BenchmarkAll(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New()
+		},
+	}
+
+	lb.Run(b)
+}
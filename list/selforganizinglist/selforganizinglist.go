@@ -1,26 +1,31 @@
 package selforganizinglist
 
 import (
+	"context"
 	"errors"
+	"iter"
 
-	List "github.com/zimmski/container/list"
+	GenericHash "github.com/zimmski/container/hash/generic"
+	"github.com/zimmski/container/hash/hashmap"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 // node holds a single node of a self organizing list
-type node struct {
-	next     *node       // The node after this node in the list
-	previous *node       // The node before this node in the list
-	value    interface{} // The value stored with this node
+type node[T any] struct {
+	next     *node[T]    // The node after this node in the list
+	previous *node[T]    // The node before this node in the list
+	value    T           // The value stored with this node
 	meta     interface{} // Holds meta data of the node for sorting
 }
 
 // iterator holds the iterator for a self organizing list
-type iterator struct {
-	current *node // The current node in traversal
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	list    *list[T] // The list of the iterator for updating the index
 }
 
 // Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
-func (iter *iterator) Next() List.Iterator {
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
 	if iter.current != nil {
 		iter.current = iter.current.next
 	}
@@ -33,7 +38,7 @@ func (iter *iterator) Next() List.Iterator {
 }
 
 // Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
-func (iter *iterator) Previous() List.Iterator {
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
 	if iter.current != nil {
 		iter.current = iter.current.previous
 	}
@@ -46,120 +51,128 @@ func (iter *iterator) Previous() List.Iterator {
 }
 
 // Get returns the value of the iterator's current element
-func (iter *iterator) Get() interface{} {
+func (iter *iterator[T]) Get() T {
 	return iter.current.value
 }
 
 // Set sets the value of the iterator's current element
-func (iter *iterator) Set(v interface{}) {
-	iter.current.value = v
+func (iter *iterator[T]) Set(v T) {
+	iter.list.setValue(iter.current, v)
 }
 
 // list holds a self organizing list
-type list struct {
-	first *node // The first node of the list
-	last  *node // The last node of the list
-	len   int   // The current list length
+type list[T any] struct {
+	first *node[T] // The first node of the list
+	last  *node[T] // The last node of the list
+	len   int      // The current list length
 
-	insertNode func(c *node) *node // Is called when a new node is created
-	accessNode func(c *node) *node // is called when a node gets accessed
-	copyList   func() *list        // is called for copying the list skeletal
+	equal func(a, b T) bool // Compares two values for lookups and removals
+
+	indexed   bool                           // True if the list maintains an index of its values
+	indexHash func(v T) uint64               // Hashes values for the index, or nil for the default hash
+	index     GenericHash.Map[T, []*node[T]] // Maps every value to its nodes, or nil if the list has no index
+	opts      []Option[T]                    // The options of the list for creating copies
+
+	strategy Strategy[T] // Reorganizes the list on insertions, accesses and removals
 }
 
-// new returns a new self organizing list skeletal
-func newList() *list {
-	l := new(list)
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
 
-	l.Clear()
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
 
-	return l
+// WithIndex lets the list maintain an index which maps every value to its nodes
+// The index makes Contains, Find, RemoveFirstOccurrence and RemoveLastOccurrence O(1) for values which are not duplicated. IndexOf and LastIndexOf find the node in O(1) but count the nodes before respectively after it, which is cheap since accessed values move to the front. The given hash function must be consistent with the equality function of the list, if it is nil values are hashed like the keys of a hash map which is only consistent with the default equality.
+func WithIndex[T any](hash func(v T) uint64) Option[T] {
+	return func(l *list[T]) {
+		l.indexed = true
+		l.indexHash = hash
+	}
 }
 
-// NewCount returns a new self organizing list with "count" method
-// The "count" method increments an access counter if a node gets accessed.
-// Afterwards the list is sorted based on the nodes counters.
-// To make this method less prone to burst accesses only nodes who are not
-// the first node will get an increased.
-func NewCount() *list {
-	l := newList()
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
 
-	l.insertNode = func(c *node) *node {
-		c.meta = 0
+// NewWithStrategy returns a new self organizing list which is reorganized by the given strategy
+func NewWithStrategy(s Strategy[interface{}], opts ...Option[interface{}]) *list[interface{}] {
+	return NewWithStrategyOf(s, opts...)
+}
 
-		return c
+// NewWithStrategyOf returns a new self organizing list for values of type T which is reorganized by the given strategy
+func NewWithStrategyOf[T any](s Strategy[T], opts ...Option[T]) *list[T] {
+	l := new(list[T])
+
+	l.equal = equal[T]
+	l.strategy = s
+
+	for _, o := range opts {
+		o(l)
 	}
-	l.accessNode = func(c *node) *node {
-		if c != l.first {
-			c.meta = c.meta.(int) + 1
 
-			for c != l.first && c.meta.(int) >= c.previous.meta.(int) {
-				// it is cheaper to just swap values
-				c.previous.value, c.value = c.value, c.previous.value
-				c.previous.meta, c.meta = c.meta, c.previous.meta
+	l.opts = opts
 
-				c = c.previous
-			}
+	if l.indexed {
+		indexOpts := []hashmap.Option[T, []*node[T]]{
+			hashmap.WithEqual[T, []*node[T]](l.equal),
 		}
 
-		return c
-	}
-	l.copyList = func() *list {
-		return NewCount()
+		if l.indexHash != nil {
+			indexOpts = append(indexOpts, hashmap.WithHash[T, []*node[T]](l.indexHash))
+		}
+
+		l.index = hashmap.NewOf(indexOpts...)
 	}
 
+	l.Clear()
+
 	return l
 }
 
-// NewMoveToFront returns a new self organizing list with "move to front" method
-// The "move to front" method puts a node to the front if it gets accessed.
-func NewMoveToFront() *list {
-	l := newList()
+// NewCount returns a new self organizing list with "count" method
+// The "count" method increments an access counter if a node gets accessed.
+// Afterwards the list is sorted based on the nodes counters.
+// To make this method less prone to burst accesses only nodes who are not
+// the first node will get an increased.
+func NewCount(opts ...Option[interface{}]) *list[interface{}] {
+	return NewCountOf(opts...)
+}
 
-	l.insertNode = func(c *node) *node {
-		return c
-	}
-	l.accessNode = func(c *node) *node {
-		if c != l.first {
-			l.removeNode(c)
-			return l.insertNodeBefore(c.value, l.first)
-		}
+// NewCountOf returns a new self organizing list with "count" method for values of type T
+func NewCountOf[T any](opts ...Option[T]) *list[T] {
+	return NewWithStrategyOf[T](countStrategy[T]{}, opts...)
+}
 
-		return c
-	}
-	l.copyList = func() *list {
-		return NewMoveToFront()
-	}
+// NewMoveToFront returns a new self organizing list with "move to front" method
+// The "move to front" method puts a node to the front if it gets accessed.
+func NewMoveToFront(opts ...Option[interface{}]) *list[interface{}] {
+	return NewMoveToFrontOf(opts...)
+}
 
-	return l
+// NewMoveToFrontOf returns a new self organizing list with "move to front" method for values of type T
+func NewMoveToFrontOf[T any](opts ...Option[T]) *list[T] {
+	return NewWithStrategyOf[T](moveToFrontStrategy[T]{}, opts...)
 }
 
 // NewTranspose returns a new self organizing list with "transpose" method
 // The "transpose" method swaps a node with its parent if it gets accessed.
-func NewTranspose() *list {
-	l := newList()
-
-	l.insertNode = func(c *node) *node {
-		return c
-	}
-	l.accessNode = func(c *node) *node {
-		if c.previous != nil {
-			// it is cheaper to just swap values
-			c.previous.value, c.value = c.value, c.previous.value
-
-			return c.previous
-		}
-
-		return c
-	}
-	l.copyList = func() *list {
-		return NewTranspose()
-	}
+func NewTranspose(opts ...Option[interface{}]) *list[interface{}] {
+	return NewTransposeOf(opts...)
+}
 
-	return l
+// NewTransposeOf returns a new self organizing list with "transpose" method for values of type T
+func NewTransposeOf[T any](opts ...Option[T]) *list[T] {
+	return NewWithStrategyOf[T](transposeStrategy[T]{}, opts...)
 }
 
 // Clear resets the list to zero elements and resets the list's meta data
-func (l *list) Clear() {
+func (l *list[T]) Clear() {
 	i := l.first
 
 	for i != nil {
@@ -174,145 +187,334 @@ func (l *list) Clear() {
 	l.first = nil
 	l.last = nil
 	l.len = 0
+
+	if l.index != nil {
+		l.index.Clear()
+	}
 }
 
 // Len returns the current list length
-func (l *list) Len() int {
+func (l *list[T]) Len() int {
 	return l.len
 }
 
 // Empty returns true if the current list length is zero
-func (l *list) Empty() bool {
+func (l *list[T]) Empty() bool {
 	return l.len == 0
 }
 
 // newNode returns a new node for the list
-func (l *list) newNode(v interface{}) *node {
-	c := &node{
+func (l *list[T]) newNode(v T) *node[T] {
+	c := &node[T]{
 		value: v,
 	}
 
-	return l.insertNode(c)
+	l.indexNode(c)
+
+	return c
 }
 
-// getNode returns the node with the given index or nil
-func (l *list) getNode(i int) (*node, error) {
-	if i > -1 && i < l.len {
-		j := 0
+// element returns the element of the given node for the strategy
+func (l *list[T]) element(c *node[T]) Element[T] {
+	return Element[T]{
+		node: c,
+		list: l,
+	}
+}
 
-		for n := l.first; n != nil; n = n.next {
-			if i == j {
-				return n, nil
-			}
+// linkNodeBefore links the given node before the node p, or at the back of the list if p is nil
+func (l *list[T]) linkNodeBefore(c *node[T], p *node[T]) {
+	if p == nil {
+		c.previous = l.last
+		c.next = nil
 
-			j++
+		if l.last == nil {
+			l.first = c
+		} else {
+			l.last.next = c
 		}
+
+		l.last = c
+	} else {
+		c.previous = p.previous
+		c.next = p
+
+		if p.previous == nil {
+			l.first = c
+		} else {
+			p.previous.next = c
+		}
+
+		p.previous = c
 	}
 
-	return nil, errors.New("index bounds out of range")
+	l.len++
 }
 
-// insertNodeBefore creates a new node from a value, inserts it before a given node and returns the new one
-func (l *list) insertNodeBefore(v interface{}, p *node) *node {
-	n := l.newNode(v)
+// unlinkNode unlinks the given node from the list
+func (l *list[T]) unlinkNode(c *node[T]) {
+	if c.previous == nil {
+		l.first = c.next
+	} else {
+		c.previous.next = c.next
+	}
 
-	if l.len == 0 {
-		l.first = n
-		l.last = n
+	if c.next == nil {
+		l.last = c.previous
 	} else {
-		if p == l.first {
-			l.first = n
-		} else {
-			if p.previous != nil {
-				p.previous.next = n
-				n.previous = p.previous
-			}
+		c.next.previous = c.previous
+	}
+
+	c.next = nil
+	c.previous = nil
+
+	l.len--
+}
+
+// indexNode adds the given node to the index of its value
+func (l *list[T]) indexNode(c *node[T]) {
+	if l.index == nil {
+		return
+	}
+
+	ns, _ := l.index.Get(c.value)
+
+	l.index.Put(c.value, append(ns, c))
+}
+
+// unindexNode removes the given node from the index of its value
+func (l *list[T]) unindexNode(c *node[T]) {
+	if l.index == nil {
+		return
+	}
+
+	ns, _ := l.index.Get(c.value)
+
+	for i, n := range ns {
+		if n == c {
+			ns[i] = ns[len(ns)-1]
+			ns[len(ns)-1] = nil
+			ns = ns[:len(ns)-1]
+
+			break
 		}
+	}
 
-		n.next = p
-		p.previous = n
+	if len(ns) == 0 {
+		l.index.Remove(c.value)
+	} else {
+		l.index.Put(c.value, ns)
 	}
+}
 
-	l.len++
+// setValue sets the value of the given node and updates the index
+func (l *list[T]) setValue(c *node[T], v T) {
+	l.unindexNode(c)
 
-	return n
+	c.value = v
+
+	l.indexNode(c)
 }
 
-// remove removes a given node from the list
-func (l *list) removeNode(c *node) interface{} {
-	if c == nil || l.len == 0 {
-		return nil
+// swapValues swaps the values of the given nodes and updates the index
+func (l *list[T]) swapValues(a, b *node[T]) {
+	if l.index == nil || l.equal(a.value, b.value) {
+		a.value, b.value = b.value, a.value
+
+		return
 	}
 
-	if c == l.first {
-		l.first = c.next
-		if c.next != nil {
-			c.next.previous = nil
+	l.unindexNode(a)
+	l.unindexNode(b)
+
+	a.value, b.value = b.value, a.value
+
+	l.indexNode(a)
+	l.indexNode(b)
+}
+
+// containsNode returns true if the given nodes contain the node c
+func containsNode[T any](ns []*node[T], c *node[T]) bool {
+	for _, n := range ns {
+		if n == c {
+			return true
 		}
+	}
 
-		// c is the last node
-		if c == l.last {
-			l.last = nil
+	return false
+}
+
+// findNode returns the first node with the given value, or nil if there is no such node
+func (l *list[T]) findNode(v T) *node[T] {
+	if l.index != nil {
+		ns, _ := l.index.Get(v)
+
+		if len(ns) < 2 {
+			if len(ns) == 0 {
+				return nil
+			}
+
+			return ns[0]
 		}
-	} else {
-		if c.previous != nil {
-			c.previous.next = c.next
 
-			if c.next != nil {
-				c.next.previous = c.previous
-			} else if c == l.last {
-				l.last = c.previous
+		// the order of duplicated values is only known by the list
+		for n := l.first; ; n = n.next {
+			if containsNode(ns, n) {
+				return n
 			}
 		}
 	}
 
-	c.next = nil
-	c.previous = nil
+	for n := l.first; n != nil; n = n.next {
+		if l.equal(n.value, v) {
+			return n
+		}
+	}
 
-	l.len--
+	return nil
+}
+
+// findLastNode returns the last node with the given value, or nil if there is no such node
+func (l *list[T]) findLastNode(v T) *node[T] {
+	if l.index != nil {
+		ns, _ := l.index.Get(v)
+
+		if len(ns) < 2 {
+			if len(ns) == 0 {
+				return nil
+			}
+
+			return ns[0]
+		}
+
+		// the order of duplicated values is only known by the list
+		for n := l.last; ; n = n.previous {
+			if containsNode(ns, n) {
+				return n
+			}
+		}
+	}
+
+	for n := l.last; n != nil; n = n.previous {
+		if l.equal(n.value, v) {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// getNode returns the node with the given index or nil
+// The node is searched from the first or the last node, whichever is the closest. Accessed nodes are not remembered since accesses reorganize the list.
+func (l *list[T]) getNode(i int) (*node[T], error) {
+	if i < 0 || i >= l.len {
+		return nil, errors.New("index bounds out of range")
+	}
+
+	if l.len-1-i < i {
+		n := l.last
+
+		for j := l.len - 1; j > i; j-- {
+			n = n.previous
+		}
+
+		return n, nil
+	}
+
+	n := l.first
+
+	for j := 0; j < i; j++ {
+		n = n.next
+	}
+
+	return n, nil
+}
+
+// insertNodeBefore creates a new node from a value, inserts it before a given node and returns the new one
+func (l *list[T]) insertNodeBefore(v T, p *node[T]) *node[T] {
+	n := l.newNode(v)
+
+	l.linkNodeBefore(n, p)
+
+	l.strategy.OnInsert(l.element(n))
+
+	return n
+}
+
+// remove removes a given node from the list
+func (l *list[T]) removeNode(c *node[T]) T {
+	if c == nil || l.len == 0 {
+		var v T
+
+		return v
+	}
+
+	l.strategy.OnRemove(l.element(c))
+
+	l.unindexNode(c)
+	l.unlinkNode(c)
 
 	return c.value
 }
 
 // newIterator returns a new iterator
-func (l *list) newIterator(current *node) *iterator {
-	return &iterator{
+func (l *list[T]) newIterator(current *node[T]) *iterator[T] {
+	return &iterator[T]{
 		current: current,
+		list:    l,
 	}
 }
 
 // Chan returns a channel which iterates from the front to the back of the list
-func (l *list) Chan(n int) <-chan interface{} {
-	ch := make(chan interface{})
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.Iter(); iter != nil; iter = iter.Next() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
-// ChanBack returns a channel which iterates from the back to the front of the list
-func (l *list) ChanBack(n int) <-chan interface{} {
-	ch := make(chan interface{})
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
 // Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
-func (l *list) Iter() List.Iterator {
+func (l *list[T]) Iter() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -321,7 +523,7 @@ func (l *list) Iter() List.Iterator {
 }
 
 // IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
-func (l *list) IterBack() List.Iterator {
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -329,68 +531,113 @@ func (l *list) IterBack() List.Iterator {
 	return l.newIterator(l.last)
 }
 
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
 // First returns the first value of the list and true, or false if there is no value
-func (l *list) First() (interface{}, bool) {
+func (l *list[T]) First() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.first.value, true
 }
 
 // Last returns the last value of the list and true, or false if there is no value
-func (l *list) Last() (interface{}, bool) {
+func (l *list[T]) Last() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.last.value, true
 }
 
 // Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Get(i int) (interface{}, error) {
+func (l *list[T]) Get(i int) (T, error) {
 	n, err := l.getNode(i)
 
 	if err != nil {
-		return nil, err
+		var v T
+
+		return v, err
 	}
 
 	return n.value, nil
 }
 
 // GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
-func (l *list) GetFunc(m func(v interface{}) bool) (interface{}, bool) {
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
-			c := l.accessNode(n)
+			l.strategy.OnAccess(l.element(n))
 
-			return c.value, true
+			return n.value, true
 		}
 	}
 
-	return nil, false
+	var v T
+
+	return v, false
 }
 
 // Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Set(i int, v interface{}) error {
+func (l *list[T]) Set(i int, v T) error {
 	n, err := l.getNode(i)
 
 	if err != nil {
 		return err
 	}
 
-	n.value = v
+	l.setValue(n, v)
 
 	return nil
 }
 
 // SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
-func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
 	for n := l.first; n != nil; n = n.next {
 		if m(n.value) {
-			n.value = v
+			l.setValue(n, v)
 
-			l.accessNode(n)
+			l.strategy.OnAccess(l.element(n))
 
 			return true
 		}
@@ -400,28 +647,59 @@ func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
 }
 
 // Swap swaps the value of index i with the value of index j
-func (l *list) Swap(i, j int) {
+func (l *list[T]) Swap(i, j int) {
 	ni, erri := l.getNode(i)
 	nj, errj := l.getNode(j)
 
 	if erri == nil && errj == nil {
-		ni.value, nj.value = nj.value, ni.value
+		l.swapValues(ni, nj)
 	}
 }
 
-// Contains returns true if the value exists in the list, or false if it does not
-func (l *list) Contains(v interface{}) bool {
-	_, ok := l.IndexOf(v)
+// Find returns the first value equal to the given value and true, or false if there is no such value
+// The found value is accessed like with GetFunc which reorganizes the list.
+func (l *list[T]) Find(v T) (T, bool) {
+	n := l.findNode(v)
+
+	if n == nil {
+		var v T
 
-	return ok
+		return v, false
+	}
+
+	l.strategy.OnAccess(l.element(n))
+
+	return n.value, true
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *list[T]) Contains(v T) bool {
+	return l.findNode(v) != nil
 }
 
 // IndexOf returns the first index of the given value and true, or false if it does not exists
-func (l *list) IndexOf(v interface{}) (int, bool) {
+func (l *list[T]) IndexOf(v T) (int, bool) {
+	if l.index != nil {
+		n := l.findNode(v)
+
+		if n == nil {
+			return -1, false
+		}
+
+		// count the nodes before the found node without comparing values
+		i := 0
+
+		for ; n.previous != nil; n = n.previous {
+			i++
+		}
+
+		return i, true
+	}
+
 	i := 0
 
 	for n := l.first; n != nil; n = n.next {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			return i, true
 		}
 
@@ -432,11 +710,28 @@ func (l *list) IndexOf(v interface{}) (int, bool) {
 }
 
 // LastIndexOf returns the last index of the given value and true, or false if it does not exists
-func (l *list) LastIndexOf(v interface{}) (int, bool) {
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
+	if l.index != nil {
+		n := l.findLastNode(v)
+
+		if n == nil {
+			return -1, false
+		}
+
+		// count the nodes after the found node without comparing values
+		i := l.len - 1
+
+		for ; n.next != nil; n = n.next {
+			i--
+		}
+
+		return i, true
+	}
+
 	i := l.len - 1
 
 	for n := l.last; n != nil; n = n.previous {
-		if n.value == v {
+		if l.equal(n.value, v) {
 			return i, true
 		}
 
@@ -447,8 +742,8 @@ func (l *list) LastIndexOf(v interface{}) (int, bool) {
 }
 
 // Copy returns an exact copy of the list
-func (l *list) Copy() List.List {
-	n := l.copyList()
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewWithStrategyOf(l.strategy, l.opts...)
 
 	for i := l.first; i != nil; i = i.next {
 		n.Push(i.value)
@@ -458,8 +753,8 @@ func (l *list) Copy() List.List {
 }
 
 // Slice returns a copy of the list as slice
-func (l *list) Slice() []interface{} {
-	a := make([]interface{}, l.len)
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
 
 	j := 0
 
@@ -473,7 +768,7 @@ func (l *list) Slice() []interface{} {
 }
 
 // Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Insert(i int, v interface{}) error {
+func (l *list[T]) Insert(i int, v T) error {
 	if i < 0 || i > l.len {
 		return errors.New("index bounds out of range")
 	}
@@ -492,9 +787,11 @@ func (l *list) Insert(i int, v interface{}) error {
 }
 
 // Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Remove(i int) (interface{}, error) {
+func (l *list[T]) Remove(i int) (T, error) {
 	if i < 0 || i >= l.len {
-		return nil, errors.New("index bounds out of range")
+		var v T
+
+		return v, errors.New("index bounds out of range")
 	}
 
 	c, _ := l.getNode(i)
@@ -503,82 +800,79 @@ func (l *list) Remove(i int) (interface{}, error) {
 }
 
 // RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveFirstOccurrence(v interface{}) bool {
-	for i := l.first; i != nil; i = i.next {
-		if i.value == v {
-			l.removeNode(i)
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	n := l.findNode(v)
 
-			return true
-		}
+	if n == nil {
+		return false
 	}
 
-	return false
+	l.removeNode(n)
+
+	return true
 }
 
 // RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveLastOccurrence(v interface{}) bool {
-	for i := l.last; i != nil; i = i.previous {
-		if i.value == v {
-			l.removeNode(i)
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	n := l.findLastNode(v)
 
-			return true
-		}
+	if n == nil {
+		return false
 	}
 
-	return false
-}
-
-// Pop removes and returns the last element and true, or false if there is no such element
-func (l *list) Pop() (interface{}, bool) {
-	r := l.removeNode(l.last)
+	l.removeNode(n)
 
-	return r, r != nil
+	return true
 }
 
-// Push inserts the given value at the end of the list
-func (l *list) Push(v interface{}) {
-	n := l.newNode(v)
-
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *list[T]) Pop() (T, bool) {
 	if l.len == 0 {
-		l.first = n
-	} else {
-		n.previous = l.last
-		l.last.next = n
+		var v T
+
+		return v, false
 	}
 
-	l.last = n
+	return l.removeNode(l.last), true
+}
 
-	l.len++
+// Push inserts the given value at the end of the list
+func (l *list[T]) Push(v T) {
+	l.insertNodeBefore(v, nil)
 }
 
 // PushList pushes the given list
-func (l *list) PushList(l2 List.List) {
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Push(iter.Get())
 	}
 }
 
 // Shift removes and returns the first element and true, or false if there is no such element
-func (l *list) Shift() (interface{}, bool) {
-	r := l.removeNode(l.first)
+func (l *list[T]) Shift() (T, bool) {
+	if l.len == 0 {
+		var v T
+
+		return v, false
+	}
 
-	return r, r != nil
+	return l.removeNode(l.first), true
 }
 
 // Unshift inserts the given value at the beginning of the list
-func (l *list) Unshift(v interface{}) {
+func (l *list[T]) Unshift(v T) {
 	l.insertNodeBefore(v, l.first)
 }
 
 // UnshiftList unshifts the given list
-func (l *list) UnshiftList(l2 List.List) {
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Unshift(iter.Get())
 	}
 }
 
 // MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveAfter(i, m int) error {
+func (l *list[T]) MoveAfter(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -601,12 +895,12 @@ func (l *list) MoveAfter(i, m int) error {
 }
 
 // MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToBack(i int) error {
+func (l *list[T]) MoveToBack(i int) error {
 	return l.MoveAfter(i, l.len-1)
 }
 
 // MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveBefore(i, m int) error {
+func (l *list[T]) MoveBefore(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -629,6 +923,107 @@ func (l *list) MoveBefore(i, m int) error {
 }
 
 // MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToFront(i int) error {
+func (l *list[T]) MoveToFront(i int) error {
 	return l.MoveBefore(i, 0)
 }
+
+// mergeSort sorts the list by relinking its nodes with a stable bottom-up merge sort
+func (l *list[T]) mergeSort(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	for k := 1; k < l.len; k *= 2 {
+		var first, last *node[T]
+
+		p := l.first
+
+		for p != nil {
+			// the run starting at p and the run starting at q are merged, both have at most k nodes
+			q := p
+			ps := 0
+
+			for ps < k && q != nil {
+				q = q.next
+				ps++
+			}
+
+			qs := k
+
+			for ps > 0 || (qs > 0 && q != nil) {
+				var c *node[T]
+
+				// take the node of the first run if the values are equal to keep the sort stable
+				if qs == 0 || q == nil || (ps > 0 && !less(q.value, p.value)) {
+					c = p
+					p = p.next
+					ps--
+				} else {
+					c = q
+					q = q.next
+					qs--
+				}
+
+				if last == nil {
+					first = c
+				} else {
+					last.next = c
+				}
+
+				last = c
+			}
+
+			p = q
+		}
+
+		last.next = nil
+
+		l.first = first
+		l.last = last
+	}
+
+	// only the next links are sorted, so the previous links have to be restored
+	var p *node[T]
+
+	for c := l.first; c != nil; c = c.next {
+		c.previous = p
+
+		p = c
+	}
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.mergeSort(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	for c := l.first; c != nil && c.next != nil; c = c.next {
+		if less(c.next.value, c.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	c := l.first
+
+	for c != nil && !less(v, c.value) {
+		c = c.next
+	}
+
+	if c == nil {
+		l.Push(v)
+	} else {
+		l.insertNodeBefore(v, c)
+	}
+}
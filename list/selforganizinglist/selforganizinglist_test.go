@@ -1,11 +1,15 @@
 package selforganizinglist
 
 import (
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	. "github.com/zimmski/container/test/assert"
 
 	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 func TestAll(t *testing.T) {
@@ -13,6 +17,9 @@ func TestAll(t *testing.T) {
 		New: func(t *testing.T) List.List {
 			return NewTranspose()
 		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return NewTranspose(WithEqual(equal))
+		},
 	}
 
 	lt.NewFilledList(t)
@@ -20,10 +27,13 @@ func TestAll(t *testing.T) {
 	lt.TestBasic(t)
 	lt.TestIterator(t)
 	lt.TestChannels(t)
+	lt.TestChannelsContext(t)
+	lt.TestSeq(t)
 	lt.TestSlice(t)
 	lt.TestInserts(t)
 	lt.TestRemove(t)
 	lt.TestRemoveOccurrence(t)
+	lt.TestEqual(t)
 	lt.TestClear(t)
 	lt.TestCopy(t)
 	lt.TestIndexOf(t)
@@ -31,11 +41,44 @@ func TestAll(t *testing.T) {
 	lt.TestAddLists(t)
 	lt.TestSwap(t)
 	lt.TestMoves(t)
+	lt.TestSort(t)
 
 	// This test methods are affected by the rearranging methods
 	//lt.TestFuncs(t)
 }
 
+func TestAllGeneric(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewTransposeOf[int]()
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewTransposeOf(WithEqual(equal))
+		},
+	}
+
+	lt.NewFilledList(t)
+
+	lt.TestBasic(t)
+	lt.TestIterator(t)
+	lt.TestChannels(t)
+	lt.TestChannelsContext(t)
+	lt.TestSeq(t)
+	lt.TestSlice(t)
+	lt.TestInserts(t)
+	lt.TestRemove(t)
+	lt.TestRemoveOccurrence(t)
+	lt.TestEqual(t)
+	lt.TestClear(t)
+	lt.TestCopy(t)
+	lt.TestIndexOf(t)
+	lt.TestGetSet(t)
+	lt.TestAddLists(t)
+	lt.TestSwap(t)
+	lt.TestMoves(t)
+	lt.TestSort(t)
+}
+
 func TestCount(t *testing.T) {
 	// GetFunc
 	l := NewCount()
@@ -288,6 +331,461 @@ func TestTranspose(t *testing.T) {
 	Equal(t, l2.Slice(), []interface{}{"zweihai", "null", 1, "vier", 3})
 }
 
+// moveAheadStrategy moves an accessed element k elements to the front
+type moveAheadStrategy[T any] struct {
+	k int
+}
+
+func (s moveAheadStrategy[T]) OnInsert(e Element[T]) {}
+
+func (s moveAheadStrategy[T]) OnAccess(e Element[T]) {
+	p := e
+
+	for i := 0; i < s.k; i++ {
+		q, ok := p.Previous()
+		if !ok {
+			break
+		}
+
+		p = q
+	}
+
+	e.MoveBefore(p)
+}
+
+func (s moveAheadStrategy[T]) OnRemove(e Element[T]) {}
+
+// recordingStrategy records all calls of a list
+type recordingStrategy struct {
+	calls []string
+}
+
+func (s *recordingStrategy) OnInsert(e Element[int]) {
+	e.SetMeta(e.Value() * 10)
+
+	s.calls = append(s.calls, fmt.Sprintf("insert %d", e.Value()))
+}
+
+func (s *recordingStrategy) OnAccess(e Element[int]) {
+	_, hasPrevious := e.Previous()
+	_, hasNext := e.Next()
+
+	s.calls = append(s.calls, fmt.Sprintf("access %d %d %t %t %t", e.Value(), e.Meta(), e.IsFirst(), hasPrevious, hasNext))
+}
+
+func (s *recordingStrategy) OnRemove(e Element[int]) {
+	s.calls = append(s.calls, fmt.Sprintf("remove %d", e.Value()))
+}
+
+func TestStrategy(t *testing.T) {
+	for _, opts := range [][]Option[interface{}]{nil, {WithIndex[interface{}](nil)}} {
+		l := NewWithStrategy(moveAheadStrategy[interface{}]{k: 2}, opts...)
+
+		for i := 0; i < 6; i++ {
+			l.Push(i)
+		}
+
+		v, ok := l.Find(5)
+		True(t, ok)
+		Equal(t, v, 5)
+		Equal(t, l.Slice(), []interface{}{0, 1, 2, 5, 3, 4})
+
+		v, ok = l.GetFunc(func(v interface{}) bool {
+			return v == 5
+		})
+		True(t, ok)
+		Equal(t, v, 5)
+		Equal(t, l.Slice(), []interface{}{0, 5, 1, 2, 3, 4})
+
+		l.Find(5)
+		Equal(t, l.Slice(), []interface{}{5, 0, 1, 2, 3, 4})
+
+		l.Find(5)
+		Equal(t, l.Slice(), []interface{}{5, 0, 1, 2, 3, 4})
+
+		True(t, l.SetFunc(func(v interface{}) bool {
+			return v == 4
+		}, 40))
+		Equal(t, l.Slice(), []interface{}{5, 0, 1, 40, 2, 3})
+
+		i, ok := l.IndexOf(40)
+		True(t, ok)
+		Equal(t, i, 3)
+
+		// copies use the same strategy
+		c := l.Copy()
+
+		c.(*list[interface{}]).Find(3)
+		Equal(t, c.Slice(), []interface{}{5, 0, 1, 3, 40, 2})
+		Equal(t, l.Slice(), []interface{}{5, 0, 1, 40, 2, 3})
+	}
+
+	// the strategy is called for every insertion, access and removal
+	s := &recordingStrategy{}
+
+	l := NewWithStrategyOf[int](s)
+
+	l.Push(1)
+	l.Unshift(0)
+	l.Insert(1, 2)
+	l.Find(2)
+	l.Find(0)
+	l.Find(3)
+	l.Remove(1)
+	l.RemoveFirstOccurrence(1)
+	l.Pop()
+
+	Equal(t, s.calls, []string{
+		"insert 1",
+		"insert 0",
+		"insert 2",
+		"access 2 20 false true true",
+		"access 0 0 true false true",
+		"remove 2",
+		"remove 1",
+		"remove 0",
+	})
+	True(t, l.Empty())
+}
+
+// indexedVariants holds constructors for all self organizing methods
+var indexedVariants = []struct {
+	name string
+	new  func(opts ...Option[int]) *list[int]
+}{
+	{"count", NewCountOf[int]},
+	{"movetofront", NewMoveToFrontOf[int]},
+	{"transpose", NewTransposeOf[int]},
+}
+
+// checkIndex checks that the index maps every value exactly to its nodes
+func checkIndex[T any](t *testing.T, l *list[T]) {
+	n := 0
+
+	for c := l.first; c != nil; c = c.next {
+		ns, ok := l.index.Get(c.value)
+		True(t, ok)
+		True(t, containsNode(ns, c))
+
+		for _, o := range ns {
+			True(t, l.equal(o.value, c.value))
+		}
+
+		n++
+	}
+
+	m := 0
+
+	for ns := range l.index.Values() {
+		True(t, len(ns) > 0)
+
+		m += len(ns)
+	}
+
+	Equal(t, m, n)
+}
+
+func TestAllIndexed(t *testing.T) {
+	lt := &List.ListTest{
+		New: func(t *testing.T) List.List {
+			return NewTranspose(WithIndex[interface{}](nil))
+		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			// all values of the equality tests have the same hash
+			return NewTranspose(WithEqual(equal), WithIndex(func(v interface{}) uint64 {
+				return 0
+			}))
+		},
+	}
+
+	lt.NewFilledList(t)
+
+	lt.TestBasic(t)
+	lt.TestIterator(t)
+	lt.TestSeq(t)
+	lt.TestSlice(t)
+	lt.TestInserts(t)
+	lt.TestRemove(t)
+	lt.TestRemoveOccurrence(t)
+	lt.TestEqual(t)
+	lt.TestClear(t)
+	lt.TestCopy(t)
+	lt.TestIndexOf(t)
+	lt.TestGetSet(t)
+	lt.TestAddLists(t)
+	lt.TestSwap(t)
+	lt.TestMoves(t)
+	lt.TestSort(t)
+
+	for _, variant := range indexedVariants {
+		t.Run(variant.name, func(t *testing.T) {
+			glt := &GenericList.ListTest{
+				New: func(t *testing.T) GenericList.List[int] {
+					return variant.new(WithIndex[int](nil))
+				},
+				NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+					return variant.new(WithEqual(equal), WithIndex(func(v int) uint64 {
+						return 0
+					}))
+				},
+			}
+
+			glt.NewFilledList(t)
+
+			glt.TestBasic(t)
+			glt.TestIterator(t)
+			glt.TestSeq(t)
+			glt.TestSlice(t)
+			glt.TestInserts(t)
+			glt.TestRemove(t)
+			glt.TestRemoveOccurrence(t)
+			glt.TestEqual(t)
+			glt.TestClear(t)
+			glt.TestCopy(t)
+			glt.TestIndexOf(t)
+			glt.TestGetSet(t)
+			glt.TestAddLists(t)
+			glt.TestSwap(t)
+			glt.TestMoves(t)
+			glt.TestSort(t)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	for _, variant := range indexedVariants {
+		t.Run(variant.name, func(t *testing.T) {
+			for _, opts := range [][]Option[int]{nil, {WithIndex[int](nil)}} {
+				l := variant.new(opts...)
+				g := variant.new()
+
+				for i := 0; i < 5; i++ {
+					l.Push(i)
+					g.Push(i)
+				}
+
+				// Find reorganizes the list like GetFunc
+				for _, v := range []int{4, 2, 2, 3, 4, 0} {
+					f, ok := l.Find(v)
+					True(t, ok)
+					Equal(t, f, v)
+
+					g.GetFunc(func(w int) bool {
+						return w == v
+					})
+
+					Equal(t, l.Slice(), g.Slice())
+				}
+
+				f, ok := l.Find(5)
+				False(t, ok)
+				Equal(t, f, 0)
+				Equal(t, l.Slice(), g.Slice())
+			}
+		})
+	}
+
+	// values are found by their keys with a custom equality and hash
+	l := NewMoveToFrontOf(WithEqual(strings.EqualFold), WithIndex(func(v string) uint64 {
+		h := uint64(0)
+
+		for _, c := range strings.ToLower(v) {
+			h = h*31 + uint64(c)
+		}
+
+		return h
+	}))
+
+	l.Push("Alpha")
+	l.Push("Beta")
+	l.Push("Gamma")
+
+	f, ok := l.Find("gamma")
+	True(t, ok)
+	Equal(t, f, "Gamma")
+	Equal(t, l.Slice(), []string{"Gamma", "Alpha", "Beta"})
+
+	True(t, l.Contains("BETA"))
+	False(t, l.Contains("delta"))
+
+	i, ok := l.IndexOf("beta")
+	True(t, ok)
+	Equal(t, i, 2)
+
+	checkIndex(t, l)
+}
+
+func TestIndexPointers(t *testing.T) {
+	type node struct {
+		value int
+	}
+
+	l := NewMoveToFrontOf(WithIndex[*node](nil))
+
+	a := &node{1}
+	b := &node{2}
+
+	l.Push(a)
+	l.Push(b)
+
+	// pointers are indexed by their address and not by the value they point to
+	a.value = 3
+
+	True(t, l.Contains(a))
+	False(t, l.Contains(&node{2}))
+
+	i, ok := l.IndexOf(b)
+	True(t, ok)
+	Equal(t, i, 1)
+
+	checkIndex(t, l)
+}
+
+func TestIndexRandomOperations(t *testing.T) {
+	for _, variant := range indexedVariants {
+		t.Run(variant.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+
+			// the list without index is the model for the list with index
+			l := variant.new(WithIndex[int](nil))
+			m := variant.new()
+
+			for i := 0; i < 3000; i++ {
+				v := r.Intn(30)
+
+				switch r.Intn(14) {
+				case 0:
+					l.Push(v)
+					m.Push(v)
+				case 1:
+					l.Unshift(v)
+					m.Unshift(v)
+				case 2:
+					j := r.Intn(l.Len() + 1)
+
+					Equal(t, l.Insert(j, v), m.Insert(j, v))
+				case 3:
+					if l.Len() > 0 {
+						j := r.Intn(l.Len())
+
+						a, _ := l.Remove(j)
+						b, _ := m.Remove(j)
+						Equal(t, a, b)
+					}
+				case 4:
+					if l.Len() > 0 {
+						j := r.Intn(l.Len())
+
+						l.Set(j, v)
+						m.Set(j, v)
+					}
+				case 5:
+					if l.Len() > 0 {
+						j, k := r.Intn(l.Len()), r.Intn(l.Len())
+
+						l.Swap(j, k)
+						m.Swap(j, k)
+					}
+				case 6, 7:
+					a, aok := l.Find(v)
+					b, bok := m.Find(v)
+					Equal(t, aok, bok)
+					Equal(t, a, b)
+				case 8:
+					a, aok := l.GetFunc(func(w int) bool {
+						return w == v
+					})
+					b, bok := m.GetFunc(func(w int) bool {
+						return w == v
+					})
+					Equal(t, aok, bok)
+					Equal(t, a, b)
+				case 9:
+					Equal(t, l.SetFunc(func(w int) bool {
+						return w == v
+					}, v+1), m.SetFunc(func(w int) bool {
+						return w == v
+					}, v+1))
+				case 10:
+					Equal(t, l.RemoveFirstOccurrence(v), m.RemoveFirstOccurrence(v))
+				case 11:
+					Equal(t, l.RemoveLastOccurrence(v), m.RemoveLastOccurrence(v))
+				case 12:
+					if iter := l.Iter(); iter != nil {
+						iter.Set(v)
+					}
+					if iter := m.Iter(); iter != nil {
+						iter.Set(v)
+					}
+				case 13:
+					if r.Intn(10) == 0 {
+						less := func(a, b int) bool {
+							return a < b
+						}
+
+						l.Sort(less)
+						m.Sort(less)
+					}
+				}
+
+				Equal(t, l.Slice(), m.Slice())
+
+				a, aok := l.IndexOf(v)
+				b, bok := m.IndexOf(v)
+				Equal(t, aok, bok)
+				Equal(t, a, b)
+
+				a, aok = l.LastIndexOf(v)
+				b, bok = m.LastIndexOf(v)
+				Equal(t, aok, bok)
+				Equal(t, a, b)
+
+				Equal(t, l.Contains(v), m.Contains(v))
+
+				checkIndex(t, l)
+			}
+
+			c := l.Copy().(*list[int])
+
+			NotNil(t, c.index)
+			checkIndex(t, c)
+
+			l.Clear()
+
+			Equal(t, l.index.Len(), 0)
+		})
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	for _, indexed := range []bool{false, true} {
+		name := "scan"
+		var opts []Option[int]
+
+		if indexed {
+			name = "index"
+			opts = append(opts, WithIndex[int](nil))
+		}
+
+		b.Run(name, func(b *testing.B) {
+			l := NewMoveToFrontOf(opts...)
+
+			for i := 0; i < 1000; i++ {
+				l.Push(i)
+			}
+
+			r := rand.New(rand.NewSource(1))
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				// most lookups are misses like in a symbol table
+				l.Contains(r.Intn(10000))
+			}
+		})
+	}
+}
+
 func BenchmarkPushSequentiel(b *testing.B) {
 	lb := &List.ListBenchmark{
 		New: func(b *testing.B) List.List {
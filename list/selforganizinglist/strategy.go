@@ -0,0 +1,147 @@
+package selforganizinglist
+
+// Strategy defines how a self organizing list reorganizes its elements
+// A strategy is shared by a list and its copies, so state of single elements should be held as their meta data.
+type Strategy[T any] interface {
+	// OnInsert is called after a new element was inserted into the list
+	OnInsert(e Element[T])
+	// OnAccess is called after an element was accessed by a lookup and can move the element
+	OnAccess(e Element[T])
+	// OnRemove is called before an element is removed from the list and must not move elements
+	OnRemove(e Element[T])
+}
+
+// Element holds an element of a self organizing list for a strategy
+// An element is only valid during the call of the strategy.
+type Element[T any] struct {
+	node *node[T] // The node of the element
+	list *list[T] // The list holding the node
+}
+
+// Value returns the value of the element
+func (e Element[T]) Value() T {
+	return e.node.value
+}
+
+// Meta returns the meta data of the element
+func (e Element[T]) Meta() interface{} {
+	return e.node.meta
+}
+
+// SetMeta sets the meta data of the element
+func (e Element[T]) SetMeta(m interface{}) {
+	e.node.meta = m
+}
+
+// Previous returns the element before this element and true, or false if this is the first element
+func (e Element[T]) Previous() (Element[T], bool) {
+	if e.node.previous == nil {
+		return Element[T]{}, false
+	}
+
+	return Element[T]{
+		node: e.node.previous,
+		list: e.list,
+	}, true
+}
+
+// Next returns the element after this element and true, or false if this is the last element
+func (e Element[T]) Next() (Element[T], bool) {
+	if e.node.next == nil {
+		return Element[T]{}, false
+	}
+
+	return Element[T]{
+		node: e.node.next,
+		list: e.list,
+	}, true
+}
+
+// IsFirst returns true if this is the first element of the list
+func (e Element[T]) IsFirst() bool {
+	return e.node == e.list.first
+}
+
+// MoveBefore moves this element before the given element
+func (e Element[T]) MoveBefore(m Element[T]) {
+	if e.node == m.node || e.node.next == m.node {
+		return
+	}
+
+	e.list.unlinkNode(e.node)
+	e.list.linkNodeBefore(e.node, m.node)
+}
+
+// MoveToFront moves this element to the front of the list
+func (e Element[T]) MoveToFront() {
+	if e.node == e.list.first {
+		return
+	}
+
+	e.list.unlinkNode(e.node)
+	e.list.linkNodeBefore(e.node, e.list.first)
+}
+
+// countStrategy holds the "count" method
+type countStrategy[T any] struct{}
+
+// OnInsert starts the access counter of the element
+func (countStrategy[T]) OnInsert(e Element[T]) {
+	e.SetMeta(0)
+}
+
+// OnAccess increments the access counter of the element if it is not the first element and moves it before all elements with a lower or equal counter
+func (countStrategy[T]) OnAccess(e Element[T]) {
+	if e.IsFirst() {
+		return
+	}
+
+	c := e.Meta().(int) + 1
+
+	e.SetMeta(c)
+
+	p, _ := e.Previous()
+
+	if p.Meta().(int) > c {
+		return
+	}
+
+	for q, ok := p.Previous(); ok && q.Meta().(int) <= c; q, ok = q.Previous() {
+		p = q
+	}
+
+	e.MoveBefore(p)
+}
+
+// OnRemove does nothing
+func (countStrategy[T]) OnRemove(e Element[T]) {}
+
+// moveToFrontStrategy holds the "move to front" method
+type moveToFrontStrategy[T any] struct{}
+
+// OnInsert does nothing
+func (moveToFrontStrategy[T]) OnInsert(e Element[T]) {}
+
+// OnAccess moves the element to the front
+func (moveToFrontStrategy[T]) OnAccess(e Element[T]) {
+	e.MoveToFront()
+}
+
+// OnRemove does nothing
+func (moveToFrontStrategy[T]) OnRemove(e Element[T]) {}
+
+// transposeStrategy holds the "transpose" method
+type transposeStrategy[T any] struct{}
+
+// OnInsert does nothing
+func (transposeStrategy[T]) OnInsert(e Element[T]) {}
+
+// OnAccess swaps the element with the element before it
+func (transposeStrategy[T]) OnAccess(e Element[T]) {
+	if p, ok := e.Previous(); ok {
+		e.MoveBefore(p)
+	}
+}
+
+// OnRemove does nothing
+func (transposeStrategy[T]) OnRemove(e Element[T]) {}
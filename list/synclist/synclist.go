@@ -0,0 +1,514 @@
+package synclist
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
+)
+
+// iterator holds a snapshot iterator for a thread-safe list
+type iterator[T any] struct {
+	list     *list[T] // The list of this iterator
+	snapshot []T      // The values of the list at the time the iterator was created
+	i        int      // The current index in the snapshot
+}
+
+// Next iterates to the next element in the snapshot and returns the iterator, or nil if there is no next element
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous element in the snapshot and returns the iterator, or nil if there is no previous element
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element in the snapshot
+func (iter *iterator[T]) Get() T {
+	return iter.snapshot[iter.i]
+}
+
+// Set sets the value of the iterator's current element in the snapshot and the value with the same index in the list
+// The value in the list is not set if the index is out of range because of concurrent modifications.
+func (iter *iterator[T]) Set(v T) {
+	iter.snapshot[iter.i] = v
+
+	_ = iter.list.Set(iter.i, v)
+}
+
+// list holds a list which is safe for concurrent use
+// All methods lock the wrapped list for their whole duration. Iterators, channels and sequences work on a snapshot of the list which is taken when they are created.
+type list[T any] struct {
+	mutex sync.RWMutex        // The lock for the wrapped list
+	list  GenericList.List[T] // The wrapped list
+}
+
+// New returns a new thread-safe list which wraps the given list
+// The given list must not be used directly afterwards.
+func New(l List.List) *list[interface{}] {
+	return NewOf(l)
+}
+
+// NewOf returns a new thread-safe list for values of type T which wraps the given list
+// The given list must not be used directly afterwards.
+func NewOf[T any](l GenericList.List[T]) *list[T] {
+	return &list[T]{
+		list: l,
+	}
+}
+
+// newIterator returns a new snapshot iterator starting at the given index, or nil if the snapshot has no such index
+func (l *list[T]) newIterator(snapshot []T, i int) GenericList.Iterator[T] {
+	if i < 0 || i >= len(snapshot) {
+		return nil
+	}
+
+	return &iterator[T]{
+		list:     l,
+		snapshot: snapshot,
+		i:        i,
+	}
+}
+
+// Clear resets the list to zero elements and resets the list's meta data
+func (l *list[T]) Clear() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.Clear()
+}
+
+// Len returns the current list length
+func (l *list[T]) Len() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.Len()
+}
+
+// Empty returns true if the current list length is zero
+func (l *list[T]) Empty() bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.Empty()
+}
+
+// Chan returns a channel which iterates from the front to the back of a snapshot of the list
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of a snapshot of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of a snapshot of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := l.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of a snapshot of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := l.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the list, or nil if there are no elements in the list
+func (l *list[T]) Iter() GenericList.Iterator[T] {
+	return l.newIterator(l.Slice(), 0)
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the list, or nil if there are no elements in the list
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
+	s := l.Slice()
+
+	return l.newIterator(s, len(s)-1)
+}
+
+// All returns a sequence which iterates from the front to the back of a snapshot of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of a snapshot of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := l.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of a snapshot of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range l.Slice() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the list and true, or false if there is no value
+func (l *list[T]) First() (T, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.First()
+}
+
+// Last returns the last value of the list and true, or false if there is no value
+func (l *list[T]) Last() (T, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.Last()
+}
+
+// Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
+// The list is locked exclusively since lists like the linked lists remember the last accessed node.
+func (l *list[T]) Get(i int) (T, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Get(i)
+}
+
+// GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
+// The list is locked exclusively since lists like the self organizing list rearrange their elements on access.
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.GetFunc(m)
+}
+
+// Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Set(i int, v T) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Set(i, v)
+}
+
+// SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.SetFunc(m, v)
+}
+
+// Swap swaps the value of index i with the value of index j
+func (l *list[T]) Swap(i, j int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.Swap(i, j)
+}
+
+// Contains returns true if the value exists in the list, or false if it does not
+func (l *list[T]) Contains(v T) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.Contains(v)
+}
+
+// IndexOf returns the first index of the given value and true, or false if it does not exists
+func (l *list[T]) IndexOf(v T) (int, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.IndexOf(v)
+}
+
+// LastIndexOf returns the last index of the given value and true, or false if it does not exists
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.LastIndexOf(v)
+}
+
+// Copy returns an exact copy of the list which is again thread-safe
+func (l *list[T]) Copy() GenericList.List[T] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return NewOf(l.list.Copy())
+}
+
+// Slice returns a copy of the list as slice
+func (l *list[T]) Slice() []T {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.Slice()
+}
+
+// Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Insert(i int, v T) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Insert(i, v)
+}
+
+// Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
+func (l *list[T]) Remove(i int) (T, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Remove(i)
+}
+
+// RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.RemoveFirstOccurrence(v)
+}
+
+// RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.RemoveLastOccurrence(v)
+}
+
+// Pop removes and returns the last element and true, or false if there is no such element
+func (l *list[T]) Pop() (T, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Pop()
+}
+
+// Push inserts the given value at the end of the list
+func (l *list[T]) Push(v T) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.Push(v)
+}
+
+// PushList pushes a snapshot of the given list
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
+	// the snapshot is taken before locking since l2 could be this list
+	s := l2.Slice()
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for _, v := range s {
+		l.list.Push(v)
+	}
+}
+
+// Shift removes and returns the first element and true, or false if there is no such element
+func (l *list[T]) Shift() (T, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.Shift()
+}
+
+// Unshift inserts the given value at the beginning of the list
+func (l *list[T]) Unshift(v T) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.Unshift(v)
+}
+
+// UnshiftList unshifts a snapshot of the given list
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
+	// the snapshot is taken before locking since l2 could be this list
+	s := l2.Slice()
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for _, v := range s {
+		l.list.Unshift(v)
+	}
+}
+
+// MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveAfter(i, m int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.MoveAfter(i, m)
+}
+
+// MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToBack(i int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.MoveToBack(i)
+}
+
+// MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
+func (l *list[T]) MoveBefore(i, m int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.MoveBefore(i, m)
+}
+
+// MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
+func (l *list[T]) MoveToFront(i int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.list.MoveToFront(i)
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.Sort(less)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.SortStable(less)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.list.IsSorted(less)
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.list.InsertSorted(v, less)
+}
+
+// PushIfAbsent inserts the given value at the end of the list and returns true if the value does not exist in the list, or false if it does
+func (l *list[T]) PushIfAbsent(v T) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.list.Contains(v) {
+		return false
+	}
+
+	l.list.Push(v)
+
+	return true
+}
+
+// PopIf removes and returns the last element and true if it is selected by the given function, or false if it is not or if there is no such element
+func (l *list[T]) PopIf(m func(v T) bool) (T, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if v, ok := l.list.Last(); !ok || !m(v) {
+		var v T
+
+		return v, false
+	}
+
+	return l.list.Pop()
+}
+
+// ShiftIf removes and returns the first element and true if it is selected by the given function, or false if it is not or if there is no such element
+func (l *list[T]) ShiftIf(m func(v T) bool) (T, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if v, ok := l.list.First(); !ok || !m(v) {
+		var v T
+
+		return v, false
+	}
+
+	return l.list.Shift()
+}
+
+// Do calls the given function with the wrapped list while the list is locked exclusively, which allows arbitrary atomic compound operations
+// The wrapped list must not be used after the given function returns.
+func (l *list[T]) Do(f func(l GenericList.List[T])) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	f(l.list)
+}
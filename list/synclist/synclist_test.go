@@ -0,0 +1,368 @@
+package synclist
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	List "github.com/zimmski/container/list"
+	dll "github.com/zimmski/container/list/doublylinkedlist"
+	GenericList "github.com/zimmski/container/list/generic"
+	ll "github.com/zimmski/container/list/linkedlist"
+	ull "github.com/zimmski/container/list/unrolledlinkedlist"
+)
+
+func TestRunAllTests(t *testing.T) {
+	lt := &List.ListTest{
+		New: func(t *testing.T) List.List {
+			return New(dll.New())
+		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(dll.New(dll.WithEqual(equal)))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf(ull.NewOf[int](7))
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(ull.NewOf(7, ull.WithEqual(equal)))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestConcurrentSuites(t *testing.T) {
+	// all suites share one list to stress the lock while the suites run on their own lists
+	shared := NewOf(dll.NewOf[int]())
+
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			shared.Push(shared.Len())
+			shared.Shift()
+
+			return NewOf(dll.NewOf[int]())
+		},
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			lt.TestBasic(t)
+			lt.TestIterator(t)
+			lt.TestSeq(t)
+			lt.TestSlice(t)
+			lt.TestInserts(t)
+			lt.TestRemove(t)
+			lt.TestRemoveOccurrence(t)
+			lt.TestClear(t)
+			lt.TestCopy(t)
+			lt.TestIndexOf(t)
+			lt.TestGetSet(t)
+			lt.TestAddLists(t)
+			lt.TestFuncs(t)
+			lt.TestSwap(t)
+			lt.TestMoves(t)
+			lt.TestSort(t)
+		}()
+	}
+
+	wg.Wait()
+
+	Equal(t, shared.Len(), 0)
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	l := NewOf(dll.NewOf[int]())
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				v := g*1000 + i
+
+				l.Push(v)
+				l.Unshift(-v - 1)
+
+				// snapshots are consistent even while other goroutines modify the list
+				if i%20 == 0 {
+					seen := make(map[int]bool)
+
+					for it := l.Iter(); it != nil; it = it.Next() {
+						False(t, seen[it.Get()])
+
+						seen[it.Get()] = true
+					}
+
+					for range l.All() {
+					}
+					for range l.Chan(1) {
+					}
+				}
+
+				l.ShiftIf(func(w int) bool {
+					return w < 0
+				})
+				l.PopIf(func(w int) bool {
+					return w < 0
+				})
+				l.Contains(v)
+				l.Len()
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	// every positive value is never removed
+	seen := make(map[int]bool)
+
+	for _, v := range l.Slice() {
+		False(t, seen[v])
+
+		seen[v] = true
+	}
+
+	for g := 0; g < 8; g++ {
+		for i := 0; i < 200; i++ {
+			True(t, seen[g*1000+i])
+		}
+	}
+}
+
+func TestConcurrentSharedList(t *testing.T) {
+	// all goroutines read and write the same list
+	for name, newList := range map[string]func() GenericList.List[int]{
+		"doublylinkedlist":   func() GenericList.List[int] { return dll.NewOf[int]() },
+		"linkedlist":         func() GenericList.List[int] { return ll.NewOf[int]() },
+		"unrolledlinkedlist": func() GenericList.List[int] { return ull.NewOf[int](7) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := NewOf(newList())
+
+			for i := 0; i < 50; i++ {
+				l.Push(i)
+			}
+
+			var wg sync.WaitGroup
+			var inserted, removed atomic.Int64
+
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+
+				go func(g int) {
+					defer wg.Done()
+
+					r := rand.New(rand.NewSource(int64(g)))
+
+					for i := 0; i < 1000; i++ {
+						// indexes can be out of range because of other goroutines
+						j := r.Intn(l.Len() + 1)
+
+						// reads are more frequent so that they also run concurrently with each other
+						switch r.Intn(8) {
+						case 0, 1, 2, 3:
+							if v, err := l.Get(j); err == nil {
+								True(t, v >= 0)
+							}
+						case 4:
+							_ = l.Set(j, g*1000+i)
+						case 5:
+							if l.Insert(j, g*1000+i) == nil {
+								inserted.Add(1)
+							}
+						case 6:
+							if v, err := l.Remove(j); err == nil {
+								True(t, v >= 0)
+
+								removed.Add(1)
+							}
+						case 7:
+							l.Contains(g*1000 + i)
+						}
+					}
+				}(g)
+			}
+
+			wg.Wait()
+
+			n := 50 + int(inserted.Load()-removed.Load())
+
+			Equal(t, l.Len(), n)
+			Equal(t, len(l.Slice()), n)
+
+			for i := 0; i < n; i++ {
+				v, err := l.Get(i)
+				Nil(t, err)
+				True(t, v >= 0)
+			}
+		})
+	}
+}
+
+func TestConcurrentGet(t *testing.T) {
+	// lists which remember their last accessed node modify themselves on Get
+	for name, newList := range map[string]func() GenericList.List[int]{
+		"doublylinkedlist":   func() GenericList.List[int] { return dll.NewOf[int]() },
+		"linkedlist":         func() GenericList.List[int] { return ll.NewOf[int]() },
+		"unrolledlinkedlist": func() GenericList.List[int] { return ull.NewOf[int](7) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := NewOf(newList())
+
+			for i := 0; i < 100; i++ {
+				l.Push(i)
+			}
+
+			var wg sync.WaitGroup
+
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+
+				go func(g int) {
+					defer wg.Done()
+
+					for i := 0; i < 500; i++ {
+						j := (g*37 + i*13) % 100
+
+						v, err := l.Get(j)
+						Nil(t, err)
+						Equal(t, v, j)
+					}
+				}(g)
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+func TestPushIfAbsent(t *testing.T) {
+	l := NewOf(dll.NewOf[int]())
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	pushed := 0
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				if l.PushIfAbsent(i) {
+					mutex.Lock()
+					pushed++
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	Equal(t, pushed, 100)
+	Equal(t, l.Len(), 100)
+
+	l.SortStable(func(a, b int) bool {
+		return a < b
+	})
+
+	for i := 0; i < 100; i++ {
+		v, err := l.Get(i)
+		Nil(t, err)
+		Equal(t, v, i)
+	}
+}
+
+func TestPopIfShiftIf(t *testing.T) {
+	l := NewOf(dll.NewOf[int]())
+
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+
+	_, ok := l.PopIf(even)
+	False(t, ok)
+	_, ok = l.ShiftIf(even)
+	False(t, ok)
+
+	for i := 0; i < 5; i++ {
+		l.Push(i)
+	}
+
+	v, ok := l.PopIf(even)
+	True(t, ok)
+	Equal(t, v, 4)
+	v, ok = l.PopIf(even)
+	False(t, ok)
+	Equal(t, v, 0)
+
+	v, ok = l.ShiftIf(even)
+	True(t, ok)
+	Equal(t, v, 0)
+	v, ok = l.ShiftIf(even)
+	False(t, ok)
+	Equal(t, v, 0)
+
+	Equal(t, l.Slice(), []int{1, 2, 3})
+
+	l.Do(func(l GenericList.List[int]) {
+		l.Push(4)
+		l.Unshift(0)
+	})
+
+	Equal(t, l.Slice(), []int{0, 1, 2, 3, 4})
+}
+
+func TestSnapshotIterator(t *testing.T) {
+	l := NewOf(dll.NewOf[int]())
+
+	for i := 0; i < 3; i++ {
+		l.Push(i)
+	}
+
+	iter := l.Iter()
+
+	// modifications after the creation of the iterator do not change the snapshot
+	l.Push(3)
+	l.Shift()
+
+	Equal(t, iter.Get(), 0)
+	Equal(t, iter.Next().Get(), 1)
+	Equal(t, iter.Next().Get(), 2)
+	Nil(t, iter.Next())
+
+	// setting a value is written through to the list
+	iter = l.Iter()
+	iter.Set(10)
+
+	Equal(t, iter.Get(), 10)
+	Equal(t, l.Slice(), []int{10, 2, 3})
+
+	// lists can be added to themselves
+	l.PushList(l)
+
+	Equal(t, l.Slice(), []int{10, 2, 3, 10, 2, 3})
+}
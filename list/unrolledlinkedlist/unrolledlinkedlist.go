@@ -1,26 +1,29 @@
 package unrolledlinkedlist
 
 import (
+	"context"
 	"errors"
+	"iter"
+	"slices"
 
-	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 )
 
 // node holds a single node with values of a unrolled linked list
-type node struct {
-	next     *node         // The node after this node in the list
-	previous *node         // The node before this node in the list
-	values   []interface{} // The values stored with this node
+type node[T any] struct {
+	next     *node[T] // The node after this node in the list
+	previous *node[T] // The node before this node in the list
+	values   []T      // The values stored with this node
 }
 
 // iterator holds the iterator for a doubly linked list
-type iterator struct {
-	current *node // The current node in traversal
-	i       int   // The current index of the current node
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	i       int      // The current index of the current node
 }
 
 // Next iterates to the next element in the list and returns the iterator, or nil if there is no next element
-func (iter *iterator) Next() List.Iterator {
+func (iter *iterator[T]) Next() GenericList.Iterator[T] {
 	iter.i++
 
 	if iter.current != nil && iter.i >= len(iter.current.values) {
@@ -36,7 +39,7 @@ func (iter *iterator) Next() List.Iterator {
 }
 
 // Previous iterates to the previous element in the list and returns the iterator, or nil if there is no previous element
-func (iter *iterator) Previous() List.Iterator {
+func (iter *iterator[T]) Previous() GenericList.Iterator[T] {
 	iter.i--
 
 	if iter.current != nil && iter.i < 0 {
@@ -55,31 +58,72 @@ func (iter *iterator) Previous() List.Iterator {
 }
 
 // Get returns the value of the iterator's current element
-func (iter *iterator) Get() interface{} {
+func (iter *iterator[T]) Get() T {
 	return iter.current.values[iter.i]
 }
 
 // Set sets the value of the iterator's current element
-func (iter *iterator) Set(v interface{}) {
+func (iter *iterator[T]) Set(v T) {
 	iter.current.values[iter.i] = v
 }
 
 // list holds a unrolled linked list
-type list struct {
-	first       *node // The first node of the list
-	last        *node // The last node of the list
-	maxElements int   // Maximum of elements per node
-	len         int   // The current list length
+type list[T any] struct {
+	first       *node[T] // The first node of the list
+	last        *node[T] // The last node of the list
+	maxElements int      // Maximum of elements per node
+	len         int      // The current list length
+
+	finger      *node[T] // The last accessed node, or nil if there is none
+	fingerStart int      // The index of the first value of the last accessed node
+
+	equal func(a, b T) bool // Compares two values for lookups and removals
+}
+
+// Option defines an option for creating a list
+type Option[T any] func(l *list[T])
+
+// WithEqual sets the function which compares values for lookups and removals, values are compared with == by default
+func WithEqual[T any](equal func(a, b T) bool) Option[T] {
+	return func(l *list[T]) {
+		l.equal = equal
+	}
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
+
+// distance returns the distance between two indices
+func distance(i, j int) int {
+	if i < j {
+		return j - i
+	}
+
+	return i - j
 }
 
 // New returns a new unrolled linked list
 // @param maxElements defines how many elements should fit in a node
-func New(maxElements int) *list {
+func New(maxElements int, opts ...Option[interface{}]) *list[interface{}] {
+	return NewOf(maxElements, opts...)
+}
+
+// NewOf returns a new unrolled linked list for values of type T
+// @param maxElements defines how many elements should fit in a node
+func NewOf[T any](maxElements int, opts ...Option[T]) *list[T] {
 	if maxElements < 1 {
 		panic("maxElements must be at least 1")
 	}
 
-	l := new(list)
+	l := new(list[T])
+
+	l.equal = equal[T]
+
+	for _, o := range opts {
+		o(l)
+	}
 
 	l.Clear()
 
@@ -89,7 +133,7 @@ func New(maxElements int) *list {
 }
 
 // Clear resets the list to zero elements and resets the list's meta data
-func (l *list) Clear() {
+func (l *list[T]) Clear() {
 	i := l.first
 
 	for i != nil {
@@ -105,24 +149,36 @@ func (l *list) Clear() {
 	l.first = nil
 	l.last = nil
 	l.len = 0
+
+	l.finger = nil
 }
 
 // Len returns the current list length
-func (l *list) Len() int {
+func (l *list[T]) Len() int {
 	return l.len
 }
 
 // Empty returns true if the current list length is zero
-func (l *list) Empty() bool {
+func (l *list[T]) Empty() bool {
 	return l.len == 0
 }
 
 // insertElement inserts the given value at index ic in the given node
-func (l *list) insertElement(v interface{}, c *node, ic int) {
+// The index i of the value in the list keeps the last accessed node up to date.
+func (l *list[T]) insertElement(v T, c *node[T], ic int, i int) {
+	if l.finger != nil && l.finger != c && l.fingerStart >= i {
+		l.fingerStart++
+	}
+
 	if c == nil || ic == 0 || len(c.values) == 0 { // begin of node
 		n := l.insertNode(c, false)
 
 		n.values = append(n.values, v)
+
+		// the new node takes over the first index of the given node
+		if l.finger != nil && l.finger == c {
+			l.finger = n
+		}
 	} else if len(c.values) == ic { // end of node
 		n := c
 
@@ -150,7 +206,18 @@ func (l *list) insertElement(v interface{}, c *node, ic int) {
 }
 
 // removeElement removes the value at index ic in the given node
-func (l *list) removeElement(c *node, ic int) interface{} {
+// The index i of the value in the list keeps the last accessed node up to date.
+func (l *list[T]) removeElement(c *node[T], ic int, i int) T {
+	if l.finger != nil && l.finger != c {
+		if l.finger == c.next {
+			// the next node could be merged into the given node, which becomes the last accessed node instead
+			l.finger = c
+			l.fingerStart -= len(c.values)
+		} else if l.fingerStart > i {
+			l.fingerStart--
+		}
+	}
+
 	v := c.values[ic]
 
 	for ; ic < len(c.values)-1; ic++ {
@@ -162,6 +229,11 @@ func (l *list) removeElement(c *node, ic int) interface{} {
 	l.len--
 
 	if len(c.values) == 0 {
+		// the next node takes over the first index of the given node
+		if l.finger == c {
+			l.finger = c.next
+		}
+
 		l.removeNode(c)
 	} else if n := c.next; l.maxElements > 3 && n != nil && len(c.values) < l.maxElements/2 {
 		if len(n.values)-2 < l.maxElements/2 { // copy the next node into the current node
@@ -186,27 +258,47 @@ func (l *list) removeElement(c *node, ic int) interface{} {
 }
 
 // newNode returns a new node for the list
-func (l *list) newNode() *node {
-	return &node{
-		values: make([]interface{}, 0, l.maxElements),
+func (l *list[T]) newNode() *node[T] {
+	return &node[T]{
+		values: make([]T, 0, l.maxElements),
 	}
 }
 
 // getNode returns the node with the given value index and the elements index, or nil and -1 if there is no such element
-func (l *list) getNode(i int) (*node, int) {
-	for c := l.first; c != nil; c = c.next {
-		if i < len(c.values) {
-			return c, i
-		}
+// The node is searched from the first, the last or the last accessed node, whichever is the closest, and becomes the last accessed node.
+func (l *list[T]) getNode(i int) (*node[T], int) {
+	if i < 0 || i >= l.len {
+		return nil, -1
+	}
 
-		i -= len(c.values)
+	c, start := l.first, 0
+
+	if l.len-1-i < i {
+		c, start = l.last, l.len-len(l.last.values)
+	}
+
+	if l.finger != nil && distance(i, l.fingerStart) < distance(i, start) {
+		c, start = l.finger, l.fingerStart
+	}
+
+	for i >= start+len(c.values) {
+		start += len(c.values)
+		c = c.next
 	}
 
-	return nil, -1
+	for i < start {
+		c = c.previous
+		start -= len(c.values)
+	}
+
+	l.finger = c
+	l.fingerStart = start
+
+	return c, i - start
 }
 
 // insertNode creates a new node from a value, inserts it after/before a given node and returns the new one
-func (l *list) insertNode(p *node, after bool) *node {
+func (l *list[T]) insertNode(p *node[T], after bool) *node[T] {
 	n := l.newNode()
 
 	if l.len == 0 {
@@ -241,7 +333,7 @@ func (l *list) insertNode(p *node, after bool) *node {
 }
 
 // remove removes a given node from the list
-func (l *list) removeNode(c *node) *node {
+func (l *list[T]) removeNode(c *node[T]) *node[T] {
 	if c == l.first {
 		l.first = c.next
 		if c.next != nil {
@@ -272,45 +364,63 @@ func (l *list) removeNode(c *node) *node {
 }
 
 // newIterator returns a new iterator
-func (l *list) newIterator(current *node, i int) *iterator {
-	return &iterator{
+func (l *list[T]) newIterator(current *node[T], i int) *iterator[T] {
+	return &iterator[T]{
 		i:       i,
 		current: current,
 	}
 }
 
 // Chan returns a channel which iterates from the front to the back of the list
-func (l *list) Chan(n int) <-chan interface{} {
-	ch := make(chan interface{})
+func (l *list[T]) Chan(n int) <-chan T {
+	return l.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the list
+func (l *list[T]) ChanBack(n int) <-chan T {
+	return l.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the list and which is closed early if the given context is done
+func (l *list[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.Iter(); iter != nil; iter = iter.Next() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
-// ChanBack returns a channel which iterates from the back to the front of the list
-func (l *list) ChanBack(n int) <-chan interface{} {
-	ch := make(chan interface{})
+// ChanBackContext returns a channel which iterates from the back to the front of the list and which is closed early if the given context is done
+func (l *list[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := l.IterBack(); iter != nil; iter = iter.Previous() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
 // Iter returns an iterator which starts at the front of the list, or nil if there are no elements in the list
-func (l *list) Iter() List.Iterator {
+func (l *list[T]) Iter() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -319,7 +429,7 @@ func (l *list) Iter() List.Iterator {
 }
 
 // IterBack returns an iterator which starts at the back of the list, or nil if there are no elements in the list
-func (l *list) IterBack() List.Iterator {
+func (l *list[T]) IterBack() GenericList.Iterator[T] {
 	if l.len == 0 {
 		return nil
 	}
@@ -327,69 +437,106 @@ func (l *list) IterBack() List.Iterator {
 	return l.newIterator(l.last, len(l.last.values)-1)
 }
 
+// All returns a sequence which iterates from the front to the back of the list
+func (l *list[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the list
+func (l *list[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := l.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence which iterates from the front to the back of the list and yields the index of every value
+func (l *list[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for it := l.Iter(); it != nil; it = it.Next() {
+			if !yield(i, it.Get()) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
 // First returns the first value of the list and true, or false if there is no value
-func (l *list) First() (interface{}, bool) {
+func (l *list[T]) First() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.first.values[0], true
 }
 
 // Last returns the last value of the list and true, or false if there is no value
-func (l *list) Last() (interface{}, bool) {
+func (l *list[T]) Last() (T, bool) {
 	if l.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return l.last.values[len(l.last.values)-1], true
 }
 
 // Get returns the value of the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Get(i int) (interface{}, error) {
-	if i > -1 && i < l.len {
-		for c := l.first; c != nil; c = c.next {
-			if i < len(c.values) {
-				return c.values[i], nil
-			}
+func (l *list[T]) Get(i int) (T, error) {
+	c, ic := l.getNode(i)
 
-			i -= len(c.values)
-		}
+	if c == nil {
+		var v T
+
+		return v, errors.New("index bounds out of range")
 	}
 
-	return nil, errors.New("index bounds out of range")
+	return c.values[ic], nil
 }
 
 // GetFunc returns the value of the first element selected by the given function and true, or false if there is no such element
-func (l *list) GetFunc(m func(v interface{}) bool) (interface{}, bool) {
+func (l *list[T]) GetFunc(m func(v T) bool) (T, bool) {
 	for iter := l.Iter(); iter != nil; iter = iter.Next() {
 		if m(iter.Get()) {
 			return iter.Get(), true
 		}
 	}
 
-	return nil, false
+	var v T
+
+	return v, false
 }
 
 // Set sets the value of the given index and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Set(i int, v interface{}) error {
-	if i > -1 && i < l.len {
-		for c := l.first; c != nil; c = c.next {
-			if i < len(c.values) {
-				c.values[i] = v
+func (l *list[T]) Set(i int, v T) error {
+	c, ic := l.getNode(i)
 
-				return nil
-			}
-
-			i -= len(c.values)
-		}
+	if c == nil {
+		return errors.New("index bounds out of range")
 	}
 
-	return errors.New("index bounds out of range")
+	c.values[ic] = v
+
+	return nil
 }
 
 // SetFunc sets the value of the first element selected by the given function and returns true, or false if there is no such element
-func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
+func (l *list[T]) SetFunc(m func(v T) bool, v T) bool {
 	for iter := l.Iter(); iter != nil; iter = iter.Next() {
 		if m(iter.Get()) {
 			iter.Set(v)
@@ -402,7 +549,7 @@ func (l *list) SetFunc(m func(v interface{}) bool, v interface{}) bool {
 }
 
 // Swap swaps the value of index i with the value of index j
-func (l *list) Swap(i, j int) {
+func (l *list[T]) Swap(i, j int) {
 	ni, ici := l.getNode(i)
 	nj, icj := l.getNode(j)
 
@@ -412,19 +559,19 @@ func (l *list) Swap(i, j int) {
 }
 
 // Contains returns true if the value exists in the list, or false if it does not
-func (l *list) Contains(v interface{}) bool {
+func (l *list[T]) Contains(v T) bool {
 	_, ok := l.IndexOf(v)
 
 	return ok
 }
 
 // IndexOf returns the first index of the given value and true, or false if it does not exists
-func (l *list) IndexOf(v interface{}) (int, bool) {
+func (l *list[T]) IndexOf(v T) (int, bool) {
 	i := 0
 
 	for n := l.first; n != nil; n = n.next {
 		for _, c := range n.values {
-			if c == v {
+			if l.equal(c, v) {
 				return i, true
 			}
 
@@ -436,12 +583,12 @@ func (l *list) IndexOf(v interface{}) (int, bool) {
 }
 
 // LastIndexOf returns the last index of the given value and true, or false if it does not exists
-func (l *list) LastIndexOf(v interface{}) (int, bool) {
+func (l *list[T]) LastIndexOf(v T) (int, bool) {
 	i := l.len - 1
 
 	for n := l.last; n != nil; n = n.previous {
 		for j := len(n.values) - 1; j > -1; j-- {
-			if n.values[j] == v {
+			if l.equal(n.values[j], v) {
 				return i, true
 			}
 
@@ -453,8 +600,8 @@ func (l *list) LastIndexOf(v interface{}) (int, bool) {
 }
 
 // Copy returns an exact copy of the list
-func (l *list) Copy() List.List {
-	n := New(l.maxElements)
+func (l *list[T]) Copy() GenericList.List[T] {
+	n := NewOf(l.maxElements, WithEqual(l.equal))
 
 	for iter := l.Iter(); iter != nil; iter = iter.Next() {
 		n.Push(iter.Get())
@@ -464,8 +611,8 @@ func (l *list) Copy() List.List {
 }
 
 // Slice returns a copy of the list as slice
-func (l *list) Slice() []interface{} {
-	a := make([]interface{}, l.len)
+func (l *list[T]) Slice() []T {
+	a := make([]T, l.len)
 
 	j := 0
 
@@ -479,7 +626,7 @@ func (l *list) Slice() []interface{} {
 }
 
 // Insert inserts a value into the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) Insert(i int, v interface{}) error {
+func (l *list[T]) Insert(i int, v T) error {
 	if i < 0 || i > l.len {
 		return errors.New("index bounds out of range")
 	}
@@ -487,7 +634,7 @@ func (l *list) Insert(i int, v interface{}) error {
 	if i != l.len {
 		c, ic := l.getNode(i)
 
-		l.insertElement(v, c, ic)
+		l.insertElement(v, c, ic, i)
 	} else { // getNode returns nil for lastIndex + 1
 		l.Push(v)
 	}
@@ -496,35 +643,47 @@ func (l *list) Insert(i int, v interface{}) error {
 }
 
 // Remove removes and returns the value with the given index and nil, or an out of bound error if the index is incorrect
-func (l *list) Remove(i int) (interface{}, error) {
+func (l *list[T]) Remove(i int) (T, error) {
 	if i < 0 || i >= l.len {
-		return nil, errors.New("index bounds out of range")
+		var v T
+
+		return v, errors.New("index bounds out of range")
 	}
 
-	return l.removeElement(l.getNode(i)), nil
+	c, ic := l.getNode(i)
+
+	return l.removeElement(c, ic, i), nil
 }
 
 // RemoveFirstOccurrence removes the first occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveFirstOccurrence(v interface{}) bool {
+func (l *list[T]) RemoveFirstOccurrence(v T) bool {
+	start := 0
+
 	for n := l.first; n != nil; n = n.next {
 		for ic, c := range n.values {
-			if c == v {
-				l.removeElement(n, ic)
+			if l.equal(c, v) {
+				l.removeElement(n, ic, start+ic)
 
 				return true
 			}
 		}
+
+		start += len(n.values)
 	}
 
 	return false
 }
 
 // RemoveLastOccurrence removes the last occurrence of the given value in the list and returns true, or false if there is no such element
-func (l *list) RemoveLastOccurrence(v interface{}) bool {
+func (l *list[T]) RemoveLastOccurrence(v T) bool {
+	start := l.len
+
 	for n := l.last; n != nil; n = n.previous {
+		start -= len(n.values)
+
 		for ic := len(n.values) - 1; ic > -1; ic-- {
-			if n.values[ic] == v {
-				l.removeElement(n, ic)
+			if l.equal(n.values[ic], v) {
+				l.removeElement(n, ic, start+ic)
 
 				return true
 			}
@@ -535,49 +694,49 @@ func (l *list) RemoveLastOccurrence(v interface{}) bool {
 }
 
 // Pop removes and returns the last element and true, or false if there is no such element
-func (l *list) Pop() (interface{}, bool) {
-	r, _ := l.Remove(l.len - 1)
+func (l *list[T]) Pop() (T, bool) {
+	r, err := l.Remove(l.len - 1)
 
-	return r, r != nil
+	return r, err == nil
 }
 
 // Push inserts the given value at the end of the list
-func (l *list) Push(v interface{}) {
+func (l *list[T]) Push(v T) {
 	if l.last == nil {
-		l.insertElement(v, nil, 0)
+		l.insertElement(v, nil, 0, 0)
 	} else {
-		l.insertElement(v, l.last, len(l.last.values))
+		l.insertElement(v, l.last, len(l.last.values), l.len)
 	}
 }
 
 // PushList pushes the given list
-func (l *list) PushList(l2 List.List) {
+func (l *list[T]) PushList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Push(iter.Get())
 	}
 }
 
 // Shift removes and returns the first element and true, or false if there is no such element
-func (l *list) Shift() (interface{}, bool) {
-	r, _ := l.Remove(0)
+func (l *list[T]) Shift() (T, bool) {
+	r, err := l.Remove(0)
 
-	return r, r != nil
+	return r, err == nil
 }
 
 // Unshift inserts the given value at the beginning of the list
-func (l *list) Unshift(v interface{}) {
-	l.insertElement(v, l.first, 0)
+func (l *list[T]) Unshift(v T) {
+	l.insertElement(v, l.first, 0, 0)
 }
 
 // UnshiftList unshifts the given list
-func (l *list) UnshiftList(l2 List.List) {
+func (l *list[T]) UnshiftList(l2 GenericList.List[T]) {
 	for iter := l2.Iter(); iter != nil; iter = iter.Next() {
 		l.Unshift(iter.Get())
 	}
 }
 
 // MoveAfter moves the element at index i after the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveAfter(i, m int) error {
+func (l *list[T]) MoveAfter(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -600,12 +759,12 @@ func (l *list) MoveAfter(i, m int) error {
 }
 
 // MoveToBack moves the element at index i to the back of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToBack(i int) error {
+func (l *list[T]) MoveToBack(i int) error {
 	return l.MoveAfter(i, l.len-1)
 }
 
 // MoveBefore moves the element at index i before the element at index m and returns nil, or an out of bound error if an index is incorrect
-func (l *list) MoveBefore(i, m int) error {
+func (l *list[T]) MoveBefore(i, m int) error {
 	if i < 0 || i >= l.len {
 		return errors.New("i bounds out of range")
 	} else if m < 0 || m >= l.len {
@@ -628,6 +787,187 @@ func (l *list) MoveBefore(i, m int) error {
 }
 
 // MoveToFront moves the element at index i to the front of the list and returns nil, or an out of bound error if the index is incorrect
-func (l *list) MoveToFront(i int) error {
+func (l *list[T]) MoveToFront(i int) error {
 	return l.MoveBefore(i, 0)
 }
+
+// run holds a sequence of linked nodes whose values are sorted
+type run[T any] struct {
+	first *node[T] // The first node of the run
+	last  *node[T] // The last node of the run
+}
+
+// compareFunc returns a compare function for the slices package which is derived from the given less function
+func compareFunc[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		} else if less(b, a) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// mergeRuns merges the two given runs into a new run of completely filled nodes
+// The nodes of the given runs are released as soon as all their values are merged, so at most two nodes are held in addition to the values of the list.
+func (l *list[T]) mergeRuns(a, b run[T], less func(a, b T) bool) run[T] {
+	var r run[T]
+
+	ca, ia := a.first, 0
+	cb, ib := b.first, 0
+
+	for ca != nil || cb != nil {
+		var v T
+
+		// take the value of the first run if the values are equal to keep the sort stable
+		if cb == nil || (ca != nil && !less(cb.values[ib], ca.values[ia])) {
+			v = ca.values[ia]
+			ia++
+
+			if ia == len(ca.values) {
+				ca, ia = l.releaseRunNode(ca, a.last), 0
+			}
+		} else {
+			v = cb.values[ib]
+			ib++
+
+			if ib == len(cb.values) {
+				cb, ib = l.releaseRunNode(cb, b.last), 0
+			}
+		}
+
+		if r.last == nil || len(r.last.values) == l.maxElements {
+			n := l.newNode()
+
+			if r.last == nil {
+				r.first = n
+			} else {
+				r.last.next = n
+				n.previous = r.last
+			}
+
+			r.last = n
+		}
+
+		r.last.values = append(r.last.values, v)
+	}
+
+	return r
+}
+
+// releaseRunNode releases the given node of a run and returns the next node of the run, or nil if the given node is the last node of the run
+func (l *list[T]) releaseRunNode(c *node[T], last *node[T]) *node[T] {
+	n := c.next
+
+	if c == last {
+		n = nil
+	}
+
+	c.next = nil
+	c.previous = nil
+	c.values = nil
+
+	return n
+}
+
+// blockSort sorts the values of every node and merges the nodes afterwards pairwise into completely filled nodes
+func (l *list[T]) blockSort(less func(a, b T) bool, stable bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// the values are moved to other nodes
+	l.finger = nil
+
+	cmp := compareFunc(less)
+
+	var runs []run[T]
+
+	for c := l.first; c != nil; c = c.next {
+		if stable {
+			slices.SortStableFunc(c.values, cmp)
+		} else {
+			slices.SortFunc(c.values, cmp)
+		}
+
+		runs = append(runs, run[T]{
+			first: c,
+			last:  c,
+		})
+	}
+
+	for len(runs) > 1 {
+		j := 0
+
+		for i := 0; i < len(runs); i += 2 {
+			if i+1 < len(runs) {
+				runs[j] = l.mergeRuns(runs[i], runs[i+1], less)
+			} else {
+				runs[j] = runs[i]
+			}
+
+			j++
+		}
+
+		runs = runs[:j]
+	}
+
+	l.first = runs[0].first
+	l.last = runs[0].last
+
+	l.first.previous = nil
+	l.last.next = nil
+}
+
+// Sort sorts the list in place with the given less function, the order of equal values is not guaranteed
+func (l *list[T]) Sort(less func(a, b T) bool) {
+	l.blockSort(less, false)
+}
+
+// SortStable sorts the list in place with the given less function and keeps the order of equal values
+func (l *list[T]) SortStable(less func(a, b T) bool) {
+	l.blockSort(less, true)
+}
+
+// IsSorted returns true if the list is sorted according to the given less function, or false if it is not
+func (l *list[T]) IsSorted(less func(a, b T) bool) bool {
+	for c := l.first; c != nil; c = c.next {
+		for i := 1; i < len(c.values); i++ {
+			if less(c.values[i], c.values[i-1]) {
+				return false
+			}
+		}
+
+		if c.next != nil && less(c.next.values[0], c.values[len(c.values)-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InsertSorted inserts the given value after all values which are not greater according to the given less function, which keeps a sorted list sorted
+func (l *list[T]) InsertSorted(v T, less func(a, b T) bool) {
+	start := 0
+
+	for c := l.first; c != nil; c = c.next {
+		for ic, w := range c.values {
+			if less(v, w) {
+				if ic == 0 && c.previous != nil {
+					// append to the previous node instead of creating a new node in front of this one
+					l.insertElement(v, c.previous, len(c.previous.values), start)
+				} else {
+					l.insertElement(v, c, ic, start+ic)
+				}
+
+				return
+			}
+		}
+
+		start += len(c.values)
+	}
+
+	l.Push(v)
+}
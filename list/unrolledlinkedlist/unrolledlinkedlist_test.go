@@ -1,11 +1,14 @@
 package unrolledlinkedlist
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 
 	. "github.com/zimmski/container/test/assert"
 
 	List "github.com/zimmski/container/list"
+	GenericList "github.com/zimmski/container/list/generic"
 	"github.com/zimmski/container/util"
 )
 
@@ -14,6 +17,22 @@ func TestRunAllTests(t *testing.T) {
 		New: func(t *testing.T) List.List {
 			return New(7)
 		},
+		NewEqual: func(t *testing.T, equal func(a, b interface{}) bool) List.List {
+			return New(7, WithEqual(equal))
+		},
+	}
+
+	lt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	lt := &GenericList.ListTest{
+		New: func(t *testing.T) GenericList.List[int] {
+			return NewOf[int](7)
+		},
+		NewEqual: func(t *testing.T, equal func(a, b int) bool) GenericList.List[int] {
+			return NewOf(7, WithEqual(equal))
+		},
 	}
 
 	lt.Run(t)
@@ -39,6 +58,37 @@ func TestGetNode(t *testing.T) {
 	Equal(t, ic, -1)
 }
 
+func TestSortFillsNodes(t *testing.T) {
+	l := NewOf[int](7)
+
+	// unshifting creates a new node for every value
+	for i := 0; i < 50; i++ {
+		l.Unshift(i)
+	}
+
+	l.Sort(func(a, b int) bool {
+		return a < b
+	})
+
+	for i := 0; i < 50; i++ {
+		v, err := l.Get(i)
+		Nil(t, err)
+		Equal(t, v, i)
+	}
+
+	nodes := 0
+
+	for c := l.first; c != nil; c = c.next {
+		if c != l.last {
+			Equal(t, len(c.values), 7)
+		}
+
+		nodes++
+	}
+
+	Equal(t, nodes, 8)
+}
+
 func TestSmallMaxElementList(t *testing.T) {
 	l := New(2)
 
@@ -59,6 +109,129 @@ func TestSmallMaxElementList(t *testing.T) {
 	l.Remove(l.Len() - 1)
 }
 
+func TestFinger(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	l := NewOf[int](4)
+	var s []int
+
+	checkFinger := func() {
+		if l.finger != nil {
+			n, start := l.first, 0
+
+			for n != l.finger {
+				start += len(n.values)
+				n = n.next
+			}
+
+			Equal(t, start, l.fingerStart)
+		}
+	}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(9) {
+		case 0, 1:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Get(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+			}
+		case 2:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				Nil(t, l.Set(j, v))
+				s[j] = v
+			}
+		case 3:
+			j := r.Intn(len(s) + 1)
+
+			Nil(t, l.Insert(j, v))
+			s = append(s[:j], append([]int{v}, s[j:]...)...)
+		case 4:
+			if len(s) > 0 {
+				j := r.Intn(len(s))
+
+				w, err := l.Remove(j)
+				Nil(t, err)
+				Equal(t, w, s[j])
+
+				s = append(s[:j], s[j+1:]...)
+			}
+		case 5:
+			if r.Intn(2) == 0 {
+				l.Push(v)
+				s = append(s, v)
+			} else {
+				l.Unshift(v)
+				s = append([]int{v}, s...)
+			}
+		case 6:
+			if r.Intn(2) == 0 {
+				if w, ok := l.Pop(); ok {
+					Equal(t, w, s[len(s)-1])
+
+					s = s[:len(s)-1]
+				}
+			} else if w, ok := l.Shift(); ok {
+				Equal(t, w, s[0])
+
+				s = s[1:]
+			}
+		case 7:
+			k := slices.Index(s, v)
+
+			if r.Intn(2) == 0 {
+				Equal(t, l.RemoveFirstOccurrence(v), k != -1)
+			} else {
+				for j, w := range s {
+					if w == v {
+						k = j
+					}
+				}
+
+				Equal(t, l.RemoveLastOccurrence(v), k != -1)
+			}
+
+			if k != -1 {
+				s = append(s[:k], s[k+1:]...)
+			}
+		case 8:
+			less := func(a, b int) bool {
+				return a < b
+			}
+
+			if r.Intn(10) == 0 {
+				l.Sort(less)
+				slices.Sort(s)
+			} else {
+				l.InsertSorted(v, less)
+
+				j := slices.IndexFunc(s, func(w int) bool {
+					return v < w
+				})
+				if j == -1 {
+					j = len(s)
+				}
+
+				s = append(s[:j], append([]int{v}, s[j:]...)...)
+			}
+		}
+
+		checkFinger()
+
+		Equal(t, l.Len(), len(s))
+
+		if i%50 == 0 {
+			Equal(t, l.Slice(), append([]int{}, s...))
+		}
+	}
+}
+
 func BenchmarkPushSequentiel(b *testing.B) {
 	lb := &List.ListBenchmark{
 		New: func(b *testing.B) List.List {
@@ -78,3 +251,43 @@ func BenchmarkUnshiftSequentiel(b *testing.B) {
 
 	lb.BenchmarkUnshiftSequentiel(b)
 }
+
+func BenchmarkGetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New(7)
+		},
+	}
+
+	lb.BenchmarkGetSequentiel(b)
+}
+
+func BenchmarkGetBackwardSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New(7)
+		},
+	}
+
+	lb.BenchmarkGetBackwardSequentiel(b)
+}
+
+func BenchmarkSetSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New(7)
+		},
+	}
+
+	lb.BenchmarkSetSequentiel(b)
+}
+
+func BenchmarkInsertRemoveSequentiel(b *testing.B) {
+	lb := &List.ListBenchmark{
+		New: func(b *testing.B) List.List {
+			return New(7)
+		},
+	}
+
+	lb.BenchmarkInsertRemoveSequentiel(b)
+}
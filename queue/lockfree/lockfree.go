@@ -0,0 +1,59 @@
+package lockfree
+
+import (
+	"github.com/zimmski/container"
+)
+
+// iterator holds a snapshot iterator
+type iterator[T any] struct {
+	snapshot []T // The values at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// newIterator returns a new snapshot iterator starting at the given index, or nil if the snapshot has no such index
+func newIterator[T any](snapshot []T, i int) container.Iterator {
+	if i < 0 || i >= len(snapshot) {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: snapshot,
+		i:        i,
+	}
+}
+
+// Next iterates to the next element in the snapshot and returns the iterator, or nil if there is no next element
+func (iter *iterator[T]) Next() container.Iterator {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous element in the snapshot and returns the iterator, or nil if there is no previous element
+func (iter *iterator[T]) Previous() container.Iterator {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current element in the snapshot
+func (iter *iterator[T]) Get() interface{} {
+	return iter.snapshot[iter.i]
+}
+
+// equal compares two values with ==
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
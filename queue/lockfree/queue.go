@@ -0,0 +1,249 @@
+package lockfree
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+
+	"github.com/zimmski/container"
+)
+
+// queueNode holds a single node of a queue
+type queueNode[T any] struct {
+	next  atomic.Pointer[queueNode[T]] // Points to the next node in the queue
+	value T                            // The value of the node
+}
+
+// queue holds a Michael-Scott queue which allows multiple producers and consumers
+// The head always points to a dummy node whose successor holds the first value. The tail points to the last or the second last node and is moved forward by every operation which notices that it lags behind.
+// Iterators, channels, sequences and slices walk the queue without stopping concurrent modifications and are therefore only weakly consistent.
+type queue[T any] struct {
+	head atomic.Pointer[queueNode[T]] // The dummy node in front of the first node
+	tail atomic.Pointer[queueNode[T]] // The last node, or a node in front of it
+	len  atomic.Int64                 // The current queue length
+}
+
+// NewQueue returns a new empty lock-free queue
+func NewQueue() *queue[interface{}] {
+	return NewQueueOf[interface{}]()
+}
+
+// NewQueueOf returns a new empty lock-free queue for values of type T
+func NewQueueOf[T any]() *queue[T] {
+	q := &queue[T]{}
+
+	n := &queueNode[T]{}
+	q.head.Store(n)
+	q.tail.Store(n)
+
+	return q
+}
+
+// Clear removes all values from the queue
+// Values which are pushed concurrently can remain in the queue.
+func (q *queue[T]) Clear() {
+	for {
+		if _, ok := q.Pop(); !ok {
+			return
+		}
+	}
+}
+
+// Len returns the current queue length
+// The length is only accurate if there are no concurrent modifications.
+func (q *queue[T]) Len() int {
+	n := q.len.Load()
+	if n < 0 {
+		return 0
+	}
+
+	return int(n)
+}
+
+// Empty returns true if the queue has no values
+func (q *queue[T]) Empty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+// Chan returns a channel which iterates from the front to the back of the queue
+// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+func (q *queue[T]) Chan(n int) <-chan T {
+	return q.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the queue
+// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+func (q *queue[T]) ChanBack(n int) <-chan T {
+	return q.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the queue and which is closed early if the given context is done
+func (q *queue[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for v := range q.All() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of a snapshot of the queue and which is closed early if the given context is done
+func (q *queue[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := q.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the queue, or nil if there are no values in the queue
+func (q *queue[T]) Iter() container.Iterator {
+	return newIterator(q.Slice(), 0)
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the queue, or nil if there are no values in the queue
+func (q *queue[T]) IterBack() container.Iterator {
+	s := q.Slice()
+
+	return newIterator(s, len(s)-1)
+}
+
+// All returns a sequence which iterates from the front to the back of the queue
+func (q *queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for c := q.head.Load().next.Load(); c != nil; c = c.next.Load() {
+			if !yield(c.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of a snapshot of the queue
+func (q *queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := q.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if the value exists in the queue, or false if it does not
+func (q *queue[T]) Contains(v T) bool {
+	for w := range q.All() {
+		if equal(v, w) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Slice returns a copy of the queue as slice
+func (q *queue[T]) Slice() []T {
+	s := make([]T, 0, q.Len())
+
+	for v := range q.All() {
+		s = append(s, v)
+	}
+
+	return s
+}
+
+// Peek returns the first value of the queue and true, or false if there is no value
+func (q *queue[T]) Peek() (T, bool) {
+	if n := q.head.Load().next.Load(); n != nil {
+		return n.value, true
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Push inserts the given value at the back of the queue
+func (q *queue[T]) Push(v T) {
+	n := &queueNode[T]{
+		value: v,
+	}
+
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// help other producers by moving the lagging tail forward
+			q.tail.CompareAndSwap(tail, next)
+
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.len.Add(1)
+
+			return
+		}
+	}
+}
+
+// Pop removes and returns the first value of the queue and true, or false if there is no value
+func (q *queue[T]) Pop() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var v T
+
+			return v, false
+		}
+
+		if head == tail {
+			// help other producers by moving the lagging tail forward
+			q.tail.CompareAndSwap(tail, next)
+
+			continue
+		}
+
+		// the next node becomes the new dummy node
+		if q.head.CompareAndSwap(head, next) {
+			q.len.Add(-1)
+
+			return next.value, true
+		}
+	}
+}
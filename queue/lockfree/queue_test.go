@@ -0,0 +1,244 @@
+package lockfree
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	dll "github.com/zimmski/container/list/doublylinkedlist"
+	"github.com/zimmski/container/list/synclist"
+)
+
+// produced identifies a value by its producer and the producer's sequence number
+type produced struct {
+	p int
+	i int
+}
+
+func TestQueueContainer(t *testing.T) {
+	var _ container.Container = NewQueue()
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			q := NewQueue()
+
+			for _, v := range vs {
+				q.Push(v)
+			}
+
+			return q
+		},
+	}
+
+	ct.Run(t)
+}
+
+func TestQueueBasic(t *testing.T) {
+	q := NewQueueOf[int]()
+
+	True(t, q.Empty())
+	Equal(t, q.Len(), 0)
+	Nil(t, q.Iter())
+	Nil(t, q.IterBack())
+
+	_, ok := q.Pop()
+	False(t, ok)
+	_, ok = q.Peek()
+	False(t, ok)
+
+	for i := 0; i < 5; i++ {
+		q.Push(i)
+	}
+
+	False(t, q.Empty())
+	Equal(t, q.Len(), 5)
+	Equal(t, q.Slice(), []int{0, 1, 2, 3, 4})
+	Equal(t, slices.Collect(q.All()), []int{0, 1, 2, 3, 4})
+	Equal(t, slices.Collect(q.Backward()), []int{4, 3, 2, 1, 0})
+	True(t, q.Contains(3))
+	False(t, q.Contains(5))
+
+	v, ok := q.Peek()
+	True(t, ok)
+	Equal(t, v, 0)
+
+	r := []int{}
+	for iter := q.Iter(); iter != nil; iter = iter.Next() {
+		r = append(r, iter.Get().(int))
+	}
+	Equal(t, r, []int{0, 1, 2, 3, 4})
+
+	r = []int{}
+	for iter := q.IterBack(); iter != nil; iter = iter.Previous() {
+		r = append(r, iter.Get().(int))
+	}
+	Equal(t, r, []int{4, 3, 2, 1, 0})
+
+	r = []int{}
+	for v := range q.Chan(2) {
+		r = append(r, v)
+	}
+	Equal(t, r, []int{0, 1, 2, 3, 4})
+
+	r = []int{}
+	for v := range q.ChanBack(2) {
+		r = append(r, v)
+	}
+	Equal(t, r, []int{4, 3, 2, 1, 0})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := q.ChanContext(ctx, 0)
+	Equal(t, <-ch, 0)
+	cancel()
+	for range ch {
+	}
+
+	for i := 0; i < 3; i++ {
+		v, ok := q.Pop()
+		True(t, ok)
+		Equal(t, v, i)
+	}
+
+	Equal(t, q.Len(), 2)
+
+	q.Clear()
+
+	True(t, q.Empty())
+	Equal(t, q.Len(), 0)
+
+	q.Push(5)
+
+	v, ok = q.Pop()
+	True(t, ok)
+	Equal(t, v, 5)
+
+	i := NewQueue()
+	i.Push("a")
+	i.Push(1)
+	Equal(t, i.Slice(), []interface{}{"a", 1})
+}
+
+func TestQueueConcurrent(t *testing.T) {
+	q := NewQueueOf[produced]()
+
+	producers, consumers, n := 4, 4, 5000
+
+	var wg sync.WaitGroup
+
+	popped := make([][]produced, consumers)
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+
+		go func(p int) {
+			defer wg.Done()
+
+			for i := 0; i < n; i++ {
+				q.Push(produced{p, i})
+			}
+		}(p)
+	}
+
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+
+		go func(c int) {
+			defer wg.Done()
+
+			for len(popped[c]) < producers*n/consumers {
+				if v, ok := q.Pop(); ok {
+					popped[c] = append(popped[c], v)
+				}
+			}
+		}(c)
+	}
+
+	wg.Wait()
+
+	True(t, q.Empty())
+	Equal(t, q.Len(), 0)
+
+	seen := make(map[produced]bool)
+
+	for c := 0; c < consumers; c++ {
+		// every consumer sees the values of one producer in the order they were pushed
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+
+		for _, v := range popped[c] {
+			False(t, seen[v])
+			True(t, v.i > last[v.p])
+
+			seen[v] = true
+			last[v.p] = v.i
+		}
+	}
+
+	Equal(t, len(seen), producers*n)
+}
+
+func TestQueueConcurrentIteration(t *testing.T) {
+	q := NewQueueOf[int]()
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 1000; i++ {
+				q.Push(g*1000 + i)
+
+				// weakly consistent walks never see a value twice
+				if i%100 == 0 {
+					seen := make(map[int]bool)
+
+					for v := range q.All() {
+						False(t, seen[v])
+
+						seen[v] = true
+					}
+				}
+
+				if i%2 == 0 {
+					q.Pop()
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	Equal(t, q.Len(), 2000)
+	Equal(t, len(q.Slice()), 2000)
+}
+
+func BenchmarkQueueLockFree(b *testing.B) {
+	q := NewQueueOf[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			q.Push(i)
+			q.Pop()
+		}
+	})
+}
+
+func BenchmarkQueueLocked(b *testing.B) {
+	q := synclist.NewOf(dll.NewOf[int]())
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			q.Push(i)
+			q.Shift()
+		}
+	})
+}
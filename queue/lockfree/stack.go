@@ -0,0 +1,214 @@
+package lockfree
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+
+	"github.com/zimmski/container"
+)
+
+// stackNode holds a single node of a stack
+type stackNode[T any] struct {
+	next  *stackNode[T] // Points to the next node in the stack, which never changes after the node is pushed
+	value T             // The value of the node
+}
+
+// stack holds a Treiber stack which allows multiple producers and consumers
+type stack[T any] struct {
+	top atomic.Pointer[stackNode[T]] // The top node of the stack
+	len atomic.Int64                 // The current stack length
+}
+
+// NewStack returns a new empty lock-free stack
+func NewStack() *stack[interface{}] {
+	return NewStackOf[interface{}]()
+}
+
+// NewStackOf returns a new empty lock-free stack for values of type T
+func NewStackOf[T any]() *stack[T] {
+	return &stack[T]{}
+}
+
+// Clear removes all values from the stack at once
+func (s *stack[T]) Clear() {
+	n := 0
+
+	for c := s.top.Swap(nil); c != nil; c = c.next {
+		n++
+	}
+
+	s.len.Add(int64(-n))
+}
+
+// Len returns the current stack length
+// The length is only accurate if there are no concurrent modifications.
+func (s *stack[T]) Len() int {
+	n := s.len.Load()
+	if n < 0 {
+		return 0
+	}
+
+	return int(n)
+}
+
+// Empty returns true if the stack has no values
+func (s *stack[T]) Empty() bool {
+	return s.top.Load() == nil
+}
+
+// Chan returns a channel which iterates from the top to the bottom of the stack
+// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+func (s *stack[T]) Chan(n int) <-chan T {
+	return s.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the bottom to the top of the stack
+// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+func (s *stack[T]) ChanBack(n int) <-chan T {
+	return s.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the top to the bottom of the stack and which is closed early if the given context is done
+func (s *stack[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for v := range s.All() {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the bottom to the top of a snapshot of the stack and which is closed early if the given context is done
+func (s *stack[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	sl := s.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(sl) - 1; i > -1; i-- {
+			select {
+			case ch <- sl[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the top of a snapshot of the stack, or nil if there are no values in the stack
+func (s *stack[T]) Iter() container.Iterator {
+	return newIterator(s.Slice(), 0)
+}
+
+// IterBack returns an iterator which starts at the bottom of a snapshot of the stack, or nil if there are no values in the stack
+func (s *stack[T]) IterBack() container.Iterator {
+	sl := s.Slice()
+
+	return newIterator(sl, len(sl)-1)
+}
+
+// All returns a sequence which iterates from the top to the bottom of the stack
+// The sequence always walks a consistent snapshot since nodes are never changed after they are pushed.
+func (s *stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for c := s.top.Load(); c != nil; c = c.next {
+			if !yield(c.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the bottom to the top of a snapshot of the stack
+func (s *stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sl := s.Slice()
+
+		for i := len(sl) - 1; i > -1; i-- {
+			if !yield(sl[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains returns true if the value exists in the stack, or false if it does not
+func (s *stack[T]) Contains(v T) bool {
+	for w := range s.All() {
+		if equal(v, w) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Slice returns a copy of the stack as slice starting with the top
+func (s *stack[T]) Slice() []T {
+	sl := make([]T, 0, s.Len())
+
+	for v := range s.All() {
+		sl = append(sl, v)
+	}
+
+	return sl
+}
+
+// Peek returns the top value of the stack and true, or false if there is no value
+func (s *stack[T]) Peek() (T, bool) {
+	if n := s.top.Load(); n != nil {
+		return n.value, true
+	}
+
+	var v T
+
+	return v, false
+}
+
+// Push inserts the given value at the top of the stack
+func (s *stack[T]) Push(v T) {
+	n := &stackNode[T]{
+		value: v,
+	}
+
+	for {
+		n.next = s.top.Load()
+
+		if s.top.CompareAndSwap(n.next, n) {
+			s.len.Add(1)
+
+			return
+		}
+	}
+}
+
+// Pop removes and returns the top value of the stack and true, or false if there is no value
+func (s *stack[T]) Pop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var v T
+
+			return v, false
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			s.len.Add(-1)
+
+			return top.value, true
+		}
+	}
+}
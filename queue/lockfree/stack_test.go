@@ -0,0 +1,212 @@
+package lockfree
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container"
+	dll "github.com/zimmski/container/list/doublylinkedlist"
+	"github.com/zimmski/container/list/synclist"
+)
+
+func TestStackContainer(t *testing.T) {
+	var _ container.Container = NewStack()
+
+	ct := &container.ContainerTest{
+		New: func(t *testing.T, vs []interface{}) container.Container {
+			s := NewStack()
+
+			for _, v := range vs {
+				s.Push(v)
+			}
+
+			return s
+		},
+	}
+
+	ct.Run(t)
+}
+
+func TestStackBasic(t *testing.T) {
+	s := NewStackOf[int]()
+
+	True(t, s.Empty())
+	Equal(t, s.Len(), 0)
+	Nil(t, s.Iter())
+	Nil(t, s.IterBack())
+
+	_, ok := s.Pop()
+	False(t, ok)
+	_, ok = s.Peek()
+	False(t, ok)
+
+	for i := 0; i < 5; i++ {
+		s.Push(i)
+	}
+
+	False(t, s.Empty())
+	Equal(t, s.Len(), 5)
+	Equal(t, s.Slice(), []int{4, 3, 2, 1, 0})
+	Equal(t, slices.Collect(s.All()), []int{4, 3, 2, 1, 0})
+	Equal(t, slices.Collect(s.Backward()), []int{0, 1, 2, 3, 4})
+	True(t, s.Contains(3))
+	False(t, s.Contains(5))
+
+	v, ok := s.Peek()
+	True(t, ok)
+	Equal(t, v, 4)
+
+	r := []int{}
+	for iter := s.Iter(); iter != nil; iter = iter.Next() {
+		r = append(r, iter.Get().(int))
+	}
+	Equal(t, r, []int{4, 3, 2, 1, 0})
+
+	r = []int{}
+	for iter := s.IterBack(); iter != nil; iter = iter.Previous() {
+		r = append(r, iter.Get().(int))
+	}
+	Equal(t, r, []int{0, 1, 2, 3, 4})
+
+	r = []int{}
+	for v := range s.Chan(2) {
+		r = append(r, v)
+	}
+	Equal(t, r, []int{4, 3, 2, 1, 0})
+
+	r = []int{}
+	for v := range s.ChanBack(2) {
+		r = append(r, v)
+	}
+	Equal(t, r, []int{0, 1, 2, 3, 4})
+
+	for i := 4; i > 1; i-- {
+		v, ok := s.Pop()
+		True(t, ok)
+		Equal(t, v, i)
+	}
+
+	Equal(t, s.Len(), 2)
+
+	s.Clear()
+
+	True(t, s.Empty())
+	Equal(t, s.Len(), 0)
+
+	s.Push(5)
+
+	v, ok = s.Pop()
+	True(t, ok)
+	Equal(t, v, 5)
+
+	i := NewStack()
+	i.Push("a")
+	i.Push(1)
+	Equal(t, i.Slice(), []interface{}{1, "a"})
+}
+
+func TestStackConcurrent(t *testing.T) {
+	s := NewStackOf[produced]()
+
+	producers, consumers, n := 4, 4, 5000
+
+	var wg sync.WaitGroup
+
+	popped := make([][]produced, consumers)
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+
+		go func(p int) {
+			defer wg.Done()
+
+			for i := 0; i < n; i++ {
+				s.Push(produced{p, i})
+			}
+		}(p)
+	}
+
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+
+		go func(c int) {
+			defer wg.Done()
+
+			for len(popped[c]) < producers*n/consumers {
+				if v, ok := s.Pop(); ok {
+					popped[c] = append(popped[c], v)
+				}
+			}
+		}(c)
+	}
+
+	wg.Wait()
+
+	True(t, s.Empty())
+	Equal(t, s.Len(), 0)
+
+	// every value is popped exactly once
+	seen := make(map[produced]bool)
+
+	for c := 0; c < consumers; c++ {
+		for _, v := range popped[c] {
+			False(t, seen[v])
+
+			seen[v] = true
+		}
+	}
+
+	Equal(t, len(seen), producers*n)
+}
+
+func TestStackConcurrentClear(t *testing.T) {
+	s := NewStackOf[int]()
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 1000; i++ {
+				s.Push(g*1000 + i)
+
+				if i%100 == 0 {
+					s.Clear()
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	// the length is accurate again as soon as there are no concurrent modifications
+	Equal(t, s.Len(), len(s.Slice()))
+}
+
+func BenchmarkStackLockFree(b *testing.B) {
+	s := NewStackOf[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkStackLocked(b *testing.B) {
+	s := synclist.NewOf(dll.NewOf[int]())
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
@@ -0,0 +1,876 @@
+package avltree
+
+import (
+	"cmp"
+	"context"
+	"iter"
+
+	dll "github.com/zimmski/container/list/doublylinkedlist"
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// node holds a single node of an AVL tree
+type node[T any] struct {
+	parent *node[T] // The parent of this node
+	left   *node[T] // The left child of this node
+	right  *node[T] // The right child of this node
+	height int      // The height of the subtree rooted at this node
+	size   int      // The node count of the subtree rooted at this node
+	value  T        // The value stored with this node
+}
+
+// next returns the in-order successor of the node, or nil if there is none
+func (c *node[T]) next() *node[T] {
+	if c.right != nil {
+		c = c.right
+
+		for c.left != nil {
+			c = c.left
+		}
+
+		return c
+	}
+
+	for c.parent != nil && c.parent.right == c {
+		c = c.parent
+	}
+
+	return c.parent
+}
+
+// previous returns the in-order predecessor of the node, or nil if there is none
+func (c *node[T]) previous() *node[T] {
+	if c.left != nil {
+		c = c.left
+
+		for c.right != nil {
+			c = c.right
+		}
+
+		return c
+	}
+
+	for c.parent != nil && c.parent.left == c {
+		c = c.parent
+	}
+
+	return c.parent
+}
+
+// iterator holds the iterator for an AVL tree
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	first   *node[T] // The first node of the traversal range, or nil if the range is not bounded at the front
+	last    *node[T] // The last node of the traversal range, or nil if the range is not bounded at the back
+}
+
+// Next iterates to the next node in the tree and returns the iterator, or nil if there is no next node
+func (iter *iterator[T]) Next() GenericTree.Iterator[T] {
+	if iter.current != nil {
+		if iter.current == iter.last {
+			iter.current = nil
+		} else {
+			iter.current = iter.current.next()
+		}
+	}
+
+	if iter.current == nil {
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous node in the tree and returns the iterator, or nil if there is no previous node
+func (iter *iterator[T]) Previous() GenericTree.Iterator[T] {
+	if iter.current != nil {
+		if iter.current == iter.first {
+			iter.current = nil
+		} else {
+			iter.current = iter.current.previous()
+		}
+	}
+
+	if iter.current == nil {
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current node
+func (iter *iterator[T]) Get() T {
+	return iter.current.value
+}
+
+// tree holds an AVL tree
+type tree[T any] struct {
+	root    *node[T]         // The root node of the tree
+	len     int              // The current node count
+	compare func(a, b T) int // Compare two values for the tree node order
+}
+
+// New returns a new AVL tree
+func New[T any](compare func(a, b T) int) *tree[T] {
+	t := new(tree[T])
+
+	t.compare = compare
+
+	t.Clear()
+
+	return t
+}
+
+// NewOrdered returns a new AVL tree for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *tree[T] {
+	return New(cmp.Compare[T])
+}
+
+// Clear resets the tree to zero nodes and resets the tree's meta data
+func (t *tree[T]) Clear() {
+	if t.len != 0 {
+		stack := dll.NewOf[*node[T]]()
+
+		stack.Push(t.root)
+
+		for stack.Len() != 0 {
+			c, _ := stack.Pop()
+
+			c.parent = nil
+
+			if c.right != nil {
+				stack.Push(c.right)
+				c.right = nil
+			}
+			if c.left != nil {
+				stack.Push(c.left)
+				c.left = nil
+			}
+		}
+	}
+
+	t.root = nil
+	t.len = 0
+}
+
+// Len returns the current node count
+func (t *tree[T]) Len() int {
+	return t.len
+}
+
+// Empty returns true if the current node count is zero
+func (t *tree[T]) Empty() bool {
+	return t.len == 0
+}
+
+// newNode returns a new node for the tree
+func (t *tree[T]) newNode(v T) *node[T] {
+	return &node[T]{
+		height: 1,
+		size:   1,
+		value:  v,
+	}
+}
+
+// height returns the height of the given subtree, which is zero for an empty subtree
+func height[T any](c *node[T]) int {
+	if c == nil {
+		return 0
+	}
+
+	return c.height
+}
+
+// size returns the node count of the given subtree, which is zero for an empty subtree
+func size[T any](c *node[T]) int {
+	if c == nil {
+		return 0
+	}
+
+	return c.size
+}
+
+// update recalculates the height and the size of the given node from its children
+func update[T any](c *node[T]) {
+	c.height = max(height(c.left), height(c.right)) + 1
+	c.size = size(c.left) + size(c.right) + 1
+}
+
+// balance returns the balance factor of the given node which is the height of the right subtree minus the height of the left subtree
+func balance[T any](c *node[T]) int {
+	return height(c.right) - height(c.left)
+}
+
+// replaceChild replaces the child o of the given parent p with the node n
+func (t *tree[T]) replaceChild(p *node[T], o *node[T], n *node[T]) {
+	if n != nil {
+		n.parent = p
+	}
+
+	if p == nil {
+		t.root = n
+	} else if p.left == o {
+		p.left = n
+	} else {
+		p.right = n
+	}
+}
+
+// rotateLeft rotates the subtree of the given node to the left and returns the new root of the subtree
+func (t *tree[T]) rotateLeft(c *node[T]) *node[T] {
+	r := c.right
+
+	c.right = r.left
+	if r.left != nil {
+		r.left.parent = c
+	}
+
+	t.replaceChild(c.parent, c, r)
+
+	r.left = c
+	c.parent = r
+
+	update(c)
+	update(r)
+
+	return r
+}
+
+// rotateRight rotates the subtree of the given node to the right and returns the new root of the subtree
+func (t *tree[T]) rotateRight(c *node[T]) *node[T] {
+	l := c.left
+
+	c.left = l.right
+	if l.right != nil {
+		l.right.parent = c
+	}
+
+	t.replaceChild(c.parent, c, l)
+
+	l.right = c
+	c.parent = l
+
+	update(c)
+	update(l)
+
+	return l
+}
+
+// rebalance restores the height balance from the given node up to the root
+func (t *tree[T]) rebalance(c *node[T]) {
+	for c != nil {
+		update(c)
+
+		switch b := balance(c); {
+		case b > 1:
+			if balance(c.right) < 0 {
+				t.rotateRight(c.right)
+			}
+
+			c = t.rotateLeft(c)
+		case b < -1:
+			if balance(c.left) > 0 {
+				t.rotateLeft(c.left)
+			}
+
+			c = t.rotateRight(c)
+		}
+
+		c = c.parent
+	}
+}
+
+// getNode returns the node identified by the given id value, or nil if there is no such node
+func (t *tree[T]) getNode(id T) *node[T] {
+	c := t.root
+
+	for c != nil {
+		r := t.compare(id, c.value)
+
+		if r == 0 {
+			return c
+		} else if r < 0 {
+			c = c.left
+		} else {
+			c = c.right
+		}
+	}
+
+	return nil
+}
+
+// getNodeFunc returns the first node selected by the given function, or nil if there is no such node
+func (t *tree[T]) getNodeFunc(m func(v T) bool) *node[T] {
+	for c := t.getFirstNode(); c != nil; c = c.next() {
+		if m(c.value) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// getFirstNode returns the node with the first value of the tree
+func (t *tree[T]) getFirstNode() *node[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	c := t.root
+
+	for c.left != nil {
+		c = c.left
+	}
+
+	return c
+}
+
+// getLastNode returns the node with the last value of the tree
+func (t *tree[T]) getLastNode() *node[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	c := t.root
+
+	for c.right != nil {
+		c = c.right
+	}
+
+	return c
+}
+
+// getFloorNode returns the last node in the tree order with a value less than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getFloorNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) <= 0 {
+			f = c
+			c = c.right
+		} else {
+			c = c.left
+		}
+	}
+
+	return f
+}
+
+// getCeilingNode returns the first node in the tree order with a value greater than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getCeilingNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) >= 0 {
+			f = c
+			c = c.left
+		} else {
+			c = c.right
+		}
+	}
+
+	return f
+}
+
+// getLowerNode returns the last node in the tree order with a value less than the given id value, or nil if there is no such node
+func (t *tree[T]) getLowerNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) < 0 {
+			f = c
+			c = c.right
+		} else {
+			c = c.left
+		}
+	}
+
+	return f
+}
+
+// getHigherNode returns the first node in the tree order with a value greater than the given id value, or nil if there is no such node
+func (t *tree[T]) getHigherNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) > 0 {
+			f = c
+			c = c.left
+		} else {
+			c = c.right
+		}
+	}
+
+	return f
+}
+
+// insert creates a new node with the given value, adds the node accordingly to the tree and rebalances the tree
+func (t *tree[T]) insert(v T) *node[T] {
+	n := t.newNode(v)
+
+	if t.len == 0 {
+		t.root = n
+	} else {
+		c := t.root
+
+		for {
+			if t.compare(n.value, c.value) <= 0 {
+				if c.left != nil {
+					c = c.left
+				} else {
+					c.left = n
+					n.parent = c
+
+					break
+				}
+			} else {
+				if c.right != nil {
+					c = c.right
+				} else {
+					c.right = n
+					n.parent = c
+
+					break
+				}
+			}
+		}
+
+		t.rebalance(c)
+	}
+
+	t.len++
+
+	return n
+}
+
+// removeNode removes the given node from the tree, rebalances the tree and returns the removed value
+func (t *tree[T]) removeNode(c *node[T]) T {
+	if c == nil {
+		var v T
+
+		return v
+	}
+
+	v := c.value
+
+	if c.left != nil && c.right != nil {
+		// two children
+		// the in-order successor takes the place of the node and is removed instead
+		s := c.right
+
+		for s.left != nil {
+			s = s.left
+		}
+
+		c.value = s.value
+		c = s
+	}
+
+	// at most one child is left
+	ch := c.left
+	if ch == nil {
+		ch = c.right
+	}
+
+	p := c.parent
+
+	t.replaceChild(p, c, ch)
+
+	c.parent = nil
+	c.left = nil
+	c.right = nil
+
+	t.rebalance(p)
+
+	t.len--
+
+	return v
+}
+
+// Chan returns a channel which iterates from the front to the back of the tree
+func (t *tree[T]) Chan(n int) <-chan T {
+	return t.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the tree
+func (t *tree[T]) ChanBack(n int) <-chan T {
+	return t.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := t.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := t.IterBack(); iter != nil; iter = iter.Previous() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) Iter() GenericTree.Iterator[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: t.getFirstNode(),
+	}
+}
+
+// IterBack returns an iterator which starts at the back of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) IterBack() GenericTree.Iterator[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: t.getLastNode(),
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the tree
+func (t *tree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the tree
+func (t *tree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the tree and true, or false if there is no value
+func (t *tree[T]) First() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.getFirstNode().value, true
+}
+
+// Last returns the last value of the tree and true, or false if there is no value
+func (t *tree[T]) Last() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.getLastNode().value, true
+}
+
+// Get returns the value of the node identified by the given id value and true, or false if there is no such node
+func (t *tree[T]) Get(id T) (T, bool) {
+	n := t.getNode(id)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// GetFunc returns the value of the first node selected by the given function and true, or false if there is no such node
+func (t *tree[T]) GetFunc(m func(v T) bool) (T, bool) {
+	n := t.getNodeFunc(m)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// Set sets the value of the node identified by the given id value and returns true, or false if there is no such node
+func (t *tree[T]) Set(id T, v T) bool {
+	n := t.getNode(id)
+
+	if n == nil {
+		return false
+	}
+
+	t.removeNode(n)
+
+	t.insert(v)
+
+	return true
+}
+
+// SetFunc sets the value of the first node selected by the given function and returns true, or false if there is no such node
+func (t *tree[T]) SetFunc(m func(v T) bool, v T) bool {
+	n := t.getNodeFunc(m)
+
+	if n == nil {
+		return false
+	}
+
+	t.removeNode(n)
+
+	t.insert(v)
+
+	return true
+}
+
+// Contains returns true if a node identified by the given id value exists in the tree, or false if it does not
+func (t *tree[T]) Contains(id T) bool {
+	return t.getNode(id) != nil
+}
+
+// copyNode returns a copy of the subtree rooted at the given node with p as parent of the copied subtree
+func copyNode[T any](c *node[T], p *node[T]) *node[T] {
+	if c == nil {
+		return nil
+	}
+
+	n := &node[T]{
+		parent: p,
+		height: c.height,
+		size:   c.size,
+		value:  c.value,
+	}
+
+	n.left = copyNode(c.left, n)
+	n.right = copyNode(c.right, n)
+
+	return n
+}
+
+// Copy returns an exact copy of the tree
+func (t *tree[T]) Copy() GenericTree.Tree[T] {
+	t2 := New(t.compare)
+
+	t2.root = copyNode(t.root, nil)
+	t2.len = t.len
+
+	return t2
+}
+
+// Slice returns a copy of the tree as a slice
+func (t *tree[T]) Slice() []T {
+	a := make([]T, t.len)
+
+	j := 0
+
+	for c := t.getFirstNode(); c != nil; c = c.next() {
+		a[j] = c.value
+
+		j++
+	}
+
+	return a
+}
+
+// Insert inserts a new node into the tree with the given value
+func (t *tree[T]) Insert(v T) {
+	t.insert(v)
+}
+
+// Remove removes the node identified by the given id value and returns its value and true, or false if there is no such node
+func (t *tree[T]) Remove(id T) (T, bool) {
+	n := t.getNode(id)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(n), true
+}
+
+// Pop removes the last node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Pop() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(t.getLastNode()), true
+}
+
+// Shift removes the first node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Shift() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(t.getFirstNode()), true
+}
+
+// Select returns the value with the given zero based rank in the tree order and true, or false if the rank is out of range
+func (t *tree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= t.len {
+		var v T
+
+		return v, false
+	}
+
+	c := t.root
+
+	for {
+		l := size(c.left)
+
+		if k < l {
+			c = c.left
+		} else if k > l {
+			k -= l + 1
+			c = c.right
+		} else {
+			return c.value, true
+		}
+	}
+}
+
+// Rank returns the count of values in the tree which are less than the given id value
+func (t *tree[T]) Rank(id T) int {
+	r := 0
+
+	for c := t.root; c != nil; {
+		if t.compare(id, c.value) <= 0 {
+			c = c.left
+		} else {
+			r += size(c.left) + 1
+			c = c.right
+		}
+	}
+
+	return r
+}
+
+// rankUpper returns the count of values in the tree which are less than or equal to the given id value
+func (t *tree[T]) rankUpper(id T) int {
+	r := 0
+
+	for c := t.root; c != nil; {
+		if t.compare(id, c.value) < 0 {
+			c = c.left
+		} else {
+			r += size(c.left) + 1
+			c = c.right
+		}
+	}
+
+	return r
+}
+
+// CountRange returns the count of values in the tree which are greater than or equal to lo and less than or equal to hi
+func (t *tree[T]) CountRange(lo, hi T) int {
+	if t.compare(lo, hi) > 0 {
+		return 0
+	}
+
+	return t.rankUpper(hi) - t.Rank(lo)
+}
+
+// nodeValue returns the value of the given node and true, or false if the node is nil
+func nodeValue[T any](c *node[T]) (T, bool) {
+	if c == nil {
+		var v T
+
+		return v, false
+	}
+
+	return c.value, true
+}
+
+// Floor returns the greatest value in the tree which is less than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Floor(id T) (T, bool) {
+	return nodeValue(t.getFloorNode(id))
+}
+
+// Ceiling returns the least value in the tree which is greater than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Ceiling(id T) (T, bool) {
+	return nodeValue(t.getCeilingNode(id))
+}
+
+// Lower returns the greatest value in the tree which is less than the given id value and true, or false if there is no such value
+func (t *tree[T]) Lower(id T) (T, bool) {
+	return nodeValue(t.getLowerNode(id))
+}
+
+// Higher returns the least value in the tree which is greater than the given id value and true, or false if there is no such value
+func (t *tree[T]) Higher(id T) (T, bool) {
+	return nodeValue(t.getHigherNode(id))
+}
+
+// IterFrom returns an iterator which starts at the least value greater than or equal to the given id value, or nil if there is no such value
+func (t *tree[T]) IterFrom(id T) GenericTree.Iterator[T] {
+	c := t.getCeilingNode(id)
+
+	if c == nil {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: c,
+	}
+}
+
+// IterRange returns an iterator which starts at the least value greater than or equal to lo and which only moves over values between lo and hi inclusively, or nil if there are no such values
+func (t *tree[T]) IterRange(lo, hi T) GenericTree.Iterator[T] {
+	if t.compare(lo, hi) > 0 {
+		return nil
+	}
+
+	first := t.getCeilingNode(lo)
+	last := t.getFloorNode(hi)
+
+	if first == nil || last == nil || t.compare(first.value, last.value) > 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: first,
+		first:   first,
+		last:    last,
+	}
+}
@@ -0,0 +1,117 @@
+package avltree
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// checkInvariants validates the parent links, the order, the subtree sizes, the heights and the height balance of the tree
+func checkInvariants[T any](t *testing.T, tr *tree[T]) {
+	if tr.root != nil {
+		Nil(t, tr.root.parent)
+	}
+
+	count := 0
+
+	var check func(c *node[T]) int
+	check = func(c *node[T]) int {
+		if c == nil {
+			return 0
+		}
+
+		count++
+
+		Equal(t, c.size, size(c.left)+size(c.right)+1, "subtree size is wrong")
+
+		if c.left != nil {
+			Equal(t, c.left.parent, c)
+			True(t, tr.compare(c.left.value, c.value) <= 0)
+		}
+		if c.right != nil {
+			Equal(t, c.right.parent, c)
+			True(t, tr.compare(c.right.value, c.value) >= 0)
+		}
+
+		hl := check(c.left)
+		hr := check(c.right)
+
+		h := max(hl, hr) + 1
+		Equal(t, c.height, h)
+		True(t, hr-hl >= -1 && hr-hl <= 1, "node is not height balanced")
+
+		return h
+	}
+
+	check(tr.root)
+
+	Equal(t, count, tr.len)
+
+	var p *node[T]
+	for c := tr.getFirstNode(); c != nil; c = c.next() {
+		if p != nil {
+			True(t, tr.compare(p.value, c.value) <= 0)
+		}
+
+		p = c
+	}
+}
+
+func TestRunAllTests(t *testing.T) {
+	tt := &Tree.TreeTest{
+		New: func(t *testing.T) Tree.Tree {
+			return GenericTree.NewCheckedTree(t, New(Tree.CompareInts), checkInvariants[interface{}])
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllOrderedTreeTests(t *testing.T) {
+	ot := &GenericTree.OrderedTreeTest{
+		New: func(t *testing.T) GenericTree.OrderedTree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	ot.Run(t)
+}
+
+func TestRunAllNavigableTreeTests(t *testing.T) {
+	nt := &GenericTree.NavigableTreeTest{
+		New: func(t *testing.T) GenericTree.NavigableTree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	nt.Run(t)
+}
+
+func TestHeight(t *testing.T) {
+	tr := NewOrdered[int]()
+
+	n := 1 << 12
+
+	for i := 0; i < n; i++ {
+		tr.Insert(i)
+	}
+
+	checkInvariants(t, tr)
+
+	// an AVL tree is at most about 1.44 times higher than a perfectly balanced tree
+	True(t, float64(tr.root.height) <= 1.45*math.Log2(float64(n+2)))
+}
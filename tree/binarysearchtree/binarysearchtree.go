@@ -1,45 +1,75 @@
 package binarysearchtree
 
 import (
-	List "github.com/zimmski/container/list"
+	"cmp"
+	"context"
+	"iter"
+
 	dll "github.com/zimmski/container/list/doublylinkedlist"
-	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
 )
 
 // node holds a single node of a binary search tree
-type node struct {
-	parent *node       // The parent of this node
-	left   *node       // The left child of this node
-	right  *node       // The right child of this node
-	value  interface{} // The value stored with this node
+type node[T any] struct {
+	parent *node[T] // The parent of this node
+	left   *node[T] // The left child of this node
+	right  *node[T] // The right child of this node
+	size   int      // The node count of the subtree rooted at this node
+	value  T        // The value stored with this node
 }
 
-// iterator holds the iterator for a binary search tree
-type iterator struct {
-	tree    *tree     // The tree of this iterator
-	current *node     // The current node in traversal
-	stack   List.List // The stack holds the current state in the traversal
-}
+// next returns the in-order successor of the node, or nil if there is none
+func (c *node[T]) next() *node[T] {
+	if c.right != nil {
+		c = c.right
 
-// Next iterates to the next node in the tree and returns the iterator, or nil if there is no next node
-func (iter *iterator) Next() Tree.Iterator {
-	if iter.current != nil {
-		if iter.current.right != nil {
-			iter.current = iter.current.right
-			iter.stack.Push(iter.current)
+		for c.left != nil {
+			c = c.left
+		}
 
-			for iter.current.left != nil {
-				iter.current = iter.current.left
+		return c
+	}
 
-				iter.stack.Push(iter.current)
-			}
+	for c.parent != nil && c.parent.right == c {
+		c = c.parent
+	}
+
+	return c.parent
+}
+
+// previous returns the in-order predecessor of the node, or nil if there is none
+func (c *node[T]) previous() *node[T] {
+	if c.left != nil {
+		c = c.left
+
+		for c.right != nil {
+			c = c.right
 		}
 
-		if iter.stack.Len() == 0 {
+		return c
+	}
+
+	for c.parent != nil && c.parent.left == c {
+		c = c.parent
+	}
+
+	return c.parent
+}
+
+// iterator holds the iterator for a binary search tree
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	first   *node[T] // The first node of the traversal range, or nil if the range is not bounded at the front
+	last    *node[T] // The last node of the traversal range, or nil if the range is not bounded at the back
+}
+
+// Next iterates to the next node in the tree and returns the iterator, or nil if there is no next node
+func (iter *iterator[T]) Next() GenericTree.Iterator[T] {
+	if iter.current != nil {
+		if iter.current == iter.last {
 			iter.current = nil
 		} else {
-			c, _ := iter.stack.Pop()
-			iter.current = c.(*node)
+			iter.current = iter.current.next()
 		}
 	}
 
@@ -51,71 +81,12 @@ func (iter *iterator) Next() Tree.Iterator {
 }
 
 // Previous iterates to the previous node in the tree and returns the iterator, or nil if there is no previous node
-func (iter *iterator) Previous() Tree.Iterator {
+func (iter *iterator[T]) Previous() GenericTree.Iterator[T] {
 	if iter.current != nil {
-		if iter.current.left != nil {
-			iter.stack.Push(iter.current)
-
-			iter.current = iter.current.left
-
-			for iter.current.right != nil {
-				iter.stack.Push(iter.current)
-
-				iter.current = iter.current.right
-			}
+		if iter.current == iter.first {
+			iter.current = nil
 		} else {
-			if iter.stack.Len() != 0 {
-				// check if we stopped at the first element
-				if c, _ := iter.stack.First(); c.(*node).parent == nil {
-					var last, p *node
-
-					it := iter.stack.Iter()
-
-					for ; it != nil; it = it.Next() {
-						last = it.Get().(*node)
-
-						if last.parent != p || (p != nil && p.left != last) {
-							break
-						}
-
-						p = last
-					}
-
-					// the stack contains only the left lane of the tree
-					// and we stopped at the first element
-					if it == nil && last.left == iter.current {
-						iter.stack.Clear()
-						iter.current = nil
-
-						return nil
-					}
-				}
-
-				if iter.tree.compare(iter.current.parent.value, iter.current.value) < 0 {
-					iter.current = iter.current.parent
-
-					if last, _ := iter.stack.Last(); iter.tree.compare(last.(*node).value, iter.current.value) == 0 {
-						iter.stack.Pop()
-					}
-
-				} else {
-					c, _ := iter.stack.Pop()
-
-					if iter.stack.Len() != 0 {
-						// we stopped at a leaf and we have to go one lane left
-						// so we go up until we are at the junction of the two lanes
-						if iter.tree.compare(c.(*node).value, iter.current.value) > 0 {
-							for iter.tree.compare(c.(*node).parent.value, iter.current.value) > 0 {
-								c, _ = iter.stack.Pop()
-							}
-							iter.stack.Pop()
-						}
-					}
-					iter.current = c.(*node).parent
-				}
-			} else {
-				iter.current = iter.current.parent
-			}
+			iter.current = iter.current.previous()
 		}
 	}
 
@@ -127,20 +98,20 @@ func (iter *iterator) Previous() Tree.Iterator {
 }
 
 // Get returns the value of the iterator's current node
-func (iter *iterator) Get() interface{} {
+func (iter *iterator[T]) Get() T {
 	return iter.current.value
 }
 
 // tree holds a binary search tree
-type tree struct {
-	root    *node                      // The root node of the tree
-	len     int                        // The current node count
-	compare func(a, b interface{}) int // Compare two values for the tree node order
+type tree[T any] struct {
+	root    *node[T]         // The root node of the tree
+	len     int              // The current node count
+	compare func(a, b T) int // Compare two values for the tree node order
 }
 
 // New returns a new binary search tree
-func New(compare func(a, b interface{}) int) *tree {
-	t := new(tree)
+func New[T any](compare func(a, b T) int) *tree[T] {
+	t := new(tree[T])
 
 	t.compare = compare
 
@@ -149,16 +120,20 @@ func New(compare func(a, b interface{}) int) *tree {
 	return t
 }
 
+// NewOrdered returns a new binary search tree for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered]() *tree[T] {
+	return New(cmp.Compare[T])
+}
+
 // Clear resets the tree to zero nodes and resets the tree's meta data
-func (t *tree) Clear() {
+func (t *tree[T]) Clear() {
 	if t.len != 0 {
-		stack := dll.New()
+		stack := dll.NewOf[*node[T]]()
 
 		stack.Push(t.root)
 
 		for stack.Len() != 0 {
-			cr, _ := stack.Pop()
-			c := cr.(*node)
+			c, _ := stack.Pop()
 
 			c.parent = nil
 
@@ -178,24 +153,34 @@ func (t *tree) Clear() {
 }
 
 // Len returns the current node count
-func (t *tree) Len() int {
+func (t *tree[T]) Len() int {
 	return t.len
 }
 
 // Empty returns true if the current node count is zero
-func (t *tree) Empty() bool {
+func (t *tree[T]) Empty() bool {
 	return t.len == 0
 }
 
 // newNode returns a new node for the tree
-func (t *tree) newNode(v interface{}) *node {
-	return &node{
+func (t *tree[T]) newNode(v T) *node[T] {
+	return &node[T]{
+		size:  1,
 		value: v,
 	}
 }
 
+// size returns the node count of the given subtree, which is zero for an empty subtree
+func size[T any](c *node[T]) int {
+	if c == nil {
+		return 0
+	}
+
+	return c.size
+}
+
 // getNode returns the node identified by the given id value, or nil if there is no such node
-func (t *tree) getNode(id interface{}) *node {
+func (t *tree[T]) getNode(id T) *node[T] {
 	if t.len == 0 {
 		return nil
 	}
@@ -226,14 +211,13 @@ func (t *tree) getNode(id interface{}) *node {
 }
 
 // getNodeFunc returns the first node selected by the given function, or nil if there is no such node
-func (t *tree) getNodeFunc(m func(v interface{}) bool) *node {
-	stack := dll.New()
+func (t *tree[T]) getNodeFunc(m func(v T) bool) *node[T] {
+	stack := dll.NewOf[*node[T]]()
 
 	stack.Push(t.root)
 
 	for stack.Len() != 0 {
-		cr, _ := stack.Pop()
-		c := cr.(*node)
+		c, _ := stack.Pop()
 
 		if m(c.value) {
 			return c
@@ -251,7 +235,7 @@ func (t *tree) getNodeFunc(m func(v interface{}) bool) *node {
 }
 
 // getFirstNode returns the node with the first value of the tree
-func (t *tree) getFirstNode() *node {
+func (t *tree[T]) getFirstNode() *node[T] {
 	if t.len == 0 {
 		return nil
 	}
@@ -266,7 +250,7 @@ func (t *tree) getFirstNode() *node {
 }
 
 // getLastNode returns the node with the last value of the tree
-func (t *tree) getLastNode() *node {
+func (t *tree[T]) getLastNode() *node[T] {
 	if t.len == 0 {
 		return nil
 	}
@@ -280,8 +264,72 @@ func (t *tree) getLastNode() *node {
 	return c
 }
 
+// getFloorNode returns the last node in the tree order with a value less than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getFloorNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) <= 0 {
+			f = c
+			c = c.right
+		} else {
+			c = c.left
+		}
+	}
+
+	return f
+}
+
+// getCeilingNode returns the first node in the tree order with a value greater than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getCeilingNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) >= 0 {
+			f = c
+			c = c.left
+		} else {
+			c = c.right
+		}
+	}
+
+	return f
+}
+
+// getLowerNode returns the last node in the tree order with a value less than the given id value, or nil if there is no such node
+func (t *tree[T]) getLowerNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) < 0 {
+			f = c
+			c = c.right
+		} else {
+			c = c.left
+		}
+	}
+
+	return f
+}
+
+// getHigherNode returns the first node in the tree order with a value greater than the given id value, or nil if there is no such node
+func (t *tree[T]) getHigherNode(id T) *node[T] {
+	var f *node[T]
+
+	for c := t.root; c != nil; {
+		if t.compare(c.value, id) > 0 {
+			f = c
+			c = c.left
+		} else {
+			c = c.right
+		}
+	}
+
+	return f
+}
+
 // insert creates a new node with the given value and adds the node accordingly to the tree
-func (t *tree) insert(v interface{}) *node {
+func (t *tree[T]) insert(v T) *node[T] {
 	n := t.newNode(v)
 
 	if t.len == 0 {
@@ -290,6 +338,8 @@ func (t *tree) insert(v interface{}) *node {
 		c := t.root
 
 		for {
+			c.size++
+
 			if t.compare(n.value, c.value) <= 0 {
 				if c.left != nil {
 					c = c.left
@@ -318,9 +368,16 @@ func (t *tree) insert(v interface{}) *node {
 }
 
 // removeNode removes the given node from the tree
-func (t *tree) removeNode(c *node) interface{} {
+func (t *tree[T]) removeNode(c *node[T]) T {
 	if c == nil {
-		return nil
+		var v T
+
+		return v
+	}
+
+	// the subtree sizes of all ancestors shrink by the removed node
+	for p := c.parent; p != nil; p = p.parent {
+		p.size--
 	}
 
 	if c.left == nil && c.right == nil {
@@ -354,6 +411,8 @@ func (t *tree) removeNode(c *node) interface{} {
 			r := c.left
 
 			for {
+				r.size += c.right.size
+
 				if r.right == nil {
 					r.right = c.right
 					c.right.parent = r
@@ -395,80 +454,103 @@ func (t *tree) removeNode(c *node) interface{} {
 }
 
 // Chan returns a channel which iterates from the front to the back of the tree
-func (t *tree) Chan(n int) <-chan interface{} {
-	ch := make(chan interface{})
+func (t *tree[T]) Chan(n int) <-chan T {
+	return t.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the tree
+func (t *tree[T]) ChanBack(n int) <-chan T {
+	return t.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := t.Iter(); iter != nil; iter = iter.Next() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
-// ChanBack returns a channel which iterates from the back to the front of the tree
-func (t *tree) ChanBack(n int) <-chan interface{} {
-	ch := make(chan interface{})
+// ChanBackContext returns a channel which iterates from the back to the front of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
 
 	go func() {
+		defer close(ch)
+
 		for iter := t.IterBack(); iter != nil; iter = iter.Previous() {
-			ch <- iter.Get()
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
 // Iter returns an iterator which starts at the front of the tree, or nil if there are no nodes in the tree
-func (t *tree) Iter() Tree.Iterator {
+func (t *tree[T]) Iter() GenericTree.Iterator[T] {
 	if t.len == 0 {
 		return nil
 	}
 
-	iter := &iterator{
-		tree:    t,
-		current: t.root,
-		stack:   dll.New(),
-	}
-
-	iter.stack.Push(iter.current)
-
-	for iter.current.left != nil {
-		iter.current = iter.current.left
-
-		iter.stack.Push(iter.current)
+	return &iterator[T]{
+		current: t.getFirstNode(),
 	}
-
-	c, _ := iter.stack.Pop()
-	iter.current = c.(*node)
-
-	return iter
 }
 
 // IterBack returns an iterator which starts at the back of the tree, or nil if there are no nodes in the tree
-func (t *tree) IterBack() Tree.Iterator {
+func (t *tree[T]) IterBack() GenericTree.Iterator[T] {
 	if t.len == 0 {
 		return nil
 	}
 
-	iter := &iterator{
-		tree:    t,
+	return &iterator[T]{
 		current: t.getLastNode(),
-		stack:   dll.New(),
 	}
+}
+
+// All returns a sequence which iterates from the front to the back of the tree
+func (t *tree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
 
-	return iter
+// Backward returns a sequence which iterates from the back to the front of the tree
+func (t *tree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
 }
 
 // First returns the first value of the tree and true, or false if there is no value
-func (t *tree) First() (interface{}, bool) {
+func (t *tree[T]) First() (T, bool) {
 	if t.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	n := t.getFirstNode()
@@ -477,9 +559,11 @@ func (t *tree) First() (interface{}, bool) {
 }
 
 // Last returns the last value of the tree and true, or false if there is no value
-func (t *tree) Last() (interface{}, bool) {
+func (t *tree[T]) Last() (T, bool) {
 	if t.len == 0 {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	n := t.getLastNode()
@@ -488,29 +572,33 @@ func (t *tree) Last() (interface{}, bool) {
 }
 
 // Get returns the value of the node identified by the given id value and true, or false if there is no such node
-func (t *tree) Get(id interface{}) (interface{}, bool) {
+func (t *tree[T]) Get(id T) (T, bool) {
 	n := t.getNode(id)
 
 	if n == nil {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return n.value, true
 }
 
 // GetFunc returns the value of the first node selected by the given function and true, or false if there is no such node
-func (t *tree) GetFunc(m func(v interface{}) bool) (interface{}, bool) {
+func (t *tree[T]) GetFunc(m func(v T) bool) (T, bool) {
 	n := t.getNodeFunc(m)
 
 	if n == nil {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return n.value, true
 }
 
 // Set sets the value of the node identified by the given id value and returns true, or false if there is no such node
-func (t *tree) Set(id interface{}, v interface{}) bool {
+func (t *tree[T]) Set(id T, v T) bool {
 	n := t.getNode(id)
 
 	if n == nil {
@@ -525,7 +613,7 @@ func (t *tree) Set(id interface{}, v interface{}) bool {
 }
 
 // SetFunc sets the value of the first node selected by the given function and returns true, or false if there is no such node
-func (t *tree) SetFunc(m func(v interface{}) bool, v interface{}) bool {
+func (t *tree[T]) SetFunc(m func(v T) bool, v T) bool {
 	n := t.getNodeFunc(m)
 
 	if n == nil {
@@ -540,23 +628,23 @@ func (t *tree) SetFunc(m func(v interface{}) bool, v interface{}) bool {
 }
 
 // Contains returns true if a node identified by the given id value exists in the tree, or false if it does not
-func (t *tree) Contains(id interface{}) bool {
+func (t *tree[T]) Contains(id T) bool {
 	return t.getNode(id) != nil
 }
 
 // Copy returns an exact copy of the tree
-func (t *tree) Copy() Tree.Tree {
+func (t *tree[T]) Copy() GenericTree.Tree[T] {
 	l2 := New(t.compare)
 
-	stack := dll.New()
+	stack := dll.NewOf[[3]*node[T]]()
 
-	stack.Push([3]*node{t.root, nil, nil})
+	stack.Push([3]*node[T]{t.root, nil, nil})
 
 	for stack.Len() != 0 {
-		cr, _ := stack.Pop()
-		c := cr.([3]*node)
+		c, _ := stack.Pop()
 
-		n := &node{
+		n := &node[T]{
+			size:  c[0].size,
 			value: c[0].value,
 		}
 
@@ -573,10 +661,10 @@ func (t *tree) Copy() Tree.Tree {
 		}
 
 		if c[0].left != nil {
-			stack.Push([3]*node{c[0].left, n, nil})
+			stack.Push([3]*node[T]{c[0].left, n, nil})
 		}
 		if c[0].right != nil {
-			stack.Push([3]*node{c[0].right, nil, n})
+			stack.Push([3]*node[T]{c[0].right, nil, n})
 		}
 	}
 
@@ -584,8 +672,8 @@ func (t *tree) Copy() Tree.Tree {
 }
 
 // Slice returns a copy of the tree as a slice
-func (t *tree) Slice() []interface{} {
-	a := make([]interface{}, t.len)
+func (t *tree[T]) Slice() []T {
+	a := make([]T, t.len)
 
 	j := 0
 
@@ -599,31 +687,170 @@ func (t *tree) Slice() []interface{} {
 }
 
 // Insert inserts a new node into the tree with the given value
-func (t *tree) Insert(v interface{}) {
+func (t *tree[T]) Insert(v T) {
 	t.insert(v)
 }
 
 // Remove removes the node identified by the given id value and returns its value and true, or false if there is no such node
-func (t *tree) Remove(id interface{}) (interface{}, bool) {
+func (t *tree[T]) Remove(id T) (T, bool) {
 	n := t.getNode(id)
 
 	if n == nil {
-		return nil, false
+		var v T
+
+		return v, false
 	}
 
 	return t.removeNode(n), true
 }
 
 // Pop removes the last node and returns its value and true, or false if there is no such node
-func (t *tree) Pop() (interface{}, bool) {
-	r := t.removeNode(t.getLastNode())
+func (t *tree[T]) Pop() (T, bool) {
+	if t.len == 0 {
+		var v T
 
-	return r, r != nil
+		return v, false
+	}
+
+	return t.removeNode(t.getLastNode()), true
 }
 
 // Shift removes the first node and returns its value and true, or false if there is no such node
-func (t *tree) Shift() (interface{}, bool) {
-	r := t.removeNode(t.getFirstNode())
+func (t *tree[T]) Shift() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(t.getFirstNode()), true
+}
+
+// Select returns the value with the given zero based rank in the tree order and true, or false if the rank is out of range
+func (t *tree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= t.len {
+		var v T
+
+		return v, false
+	}
+
+	c := t.root
+
+	for {
+		l := size(c.left)
+
+		if k < l {
+			c = c.left
+		} else if k > l {
+			k -= l + 1
+			c = c.right
+		} else {
+			return c.value, true
+		}
+	}
+}
+
+// Rank returns the count of values in the tree which are less than the given id value
+func (t *tree[T]) Rank(id T) int {
+	r := 0
 
-	return r, r != nil
+	for c := t.root; c != nil; {
+		if t.compare(id, c.value) <= 0 {
+			c = c.left
+		} else {
+			r += size(c.left) + 1
+			c = c.right
+		}
+	}
+
+	return r
+}
+
+// rankUpper returns the count of values in the tree which are less than or equal to the given id value
+func (t *tree[T]) rankUpper(id T) int {
+	r := 0
+
+	for c := t.root; c != nil; {
+		if t.compare(id, c.value) < 0 {
+			c = c.left
+		} else {
+			r += size(c.left) + 1
+			c = c.right
+		}
+	}
+
+	return r
+}
+
+// CountRange returns the count of values in the tree which are greater than or equal to lo and less than or equal to hi
+func (t *tree[T]) CountRange(lo, hi T) int {
+	if t.compare(lo, hi) > 0 {
+		return 0
+	}
+
+	return t.rankUpper(hi) - t.Rank(lo)
+}
+
+// nodeValue returns the value of the given node and true, or false if the node is nil
+func nodeValue[T any](c *node[T]) (T, bool) {
+	if c == nil {
+		var v T
+
+		return v, false
+	}
+
+	return c.value, true
+}
+
+// Floor returns the greatest value in the tree which is less than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Floor(id T) (T, bool) {
+	return nodeValue(t.getFloorNode(id))
+}
+
+// Ceiling returns the least value in the tree which is greater than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Ceiling(id T) (T, bool) {
+	return nodeValue(t.getCeilingNode(id))
+}
+
+// Lower returns the greatest value in the tree which is less than the given id value and true, or false if there is no such value
+func (t *tree[T]) Lower(id T) (T, bool) {
+	return nodeValue(t.getLowerNode(id))
+}
+
+// Higher returns the least value in the tree which is greater than the given id value and true, or false if there is no such value
+func (t *tree[T]) Higher(id T) (T, bool) {
+	return nodeValue(t.getHigherNode(id))
+}
+
+// IterFrom returns an iterator which starts at the least value greater than or equal to the given id value, or nil if there is no such value
+func (t *tree[T]) IterFrom(id T) GenericTree.Iterator[T] {
+	c := t.getCeilingNode(id)
+
+	if c == nil {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: c,
+	}
+}
+
+// IterRange returns an iterator which starts at the least value greater than or equal to lo and which only moves over values between lo and hi inclusively, or nil if there are no such values
+func (t *tree[T]) IterRange(lo, hi T) GenericTree.Iterator[T] {
+	if t.compare(lo, hi) > 0 {
+		return nil
+	}
+
+	first := t.getCeilingNode(lo)
+	last := t.getFloorNode(hi)
+
+	if first == nil || last == nil || t.compare(first.value, last.value) > 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: first,
+		first:   first,
+		last:    last,
+	}
 }
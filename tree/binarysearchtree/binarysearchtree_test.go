@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
 )
 
 func TestRunAllTests(t *testing.T) {
@@ -24,3 +25,45 @@ func TestRunAllTests(t *testing.T) {
 
 	tt.Run(t)
 }
+
+func TestRunAllGenericTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return New(func(a, b int) int {
+				return a - b
+			})
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllOrderedTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllOrderedTreeTests(t *testing.T) {
+	ot := &GenericTree.OrderedTreeTest{
+		New: func(t *testing.T) GenericTree.OrderedTree[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	ot.Run(t)
+}
+
+func TestRunAllNavigableTreeTests(t *testing.T) {
+	nt := &GenericTree.NavigableTreeTest{
+		New: func(t *testing.T) GenericTree.NavigableTree[int] {
+			return NewOrdered[int]()
+		},
+	}
+
+	nt.Run(t)
+}
@@ -0,0 +1,92 @@
+package generic
+
+import (
+	"testing"
+)
+
+// orderedNavigableTree defines a tree which supports order statistic queries as well as bound queries
+type orderedNavigableTree[T any] interface {
+	OrderedTree[T]
+	NavigableTree[T]
+}
+
+// checkedTree holds a tree whose invariants are validated after every mutation
+type checkedTree[T any, N orderedNavigableTree[T]] struct {
+	orderedNavigableTree[T]
+
+	tree  N                        // The wrapped tree
+	check func(t *testing.T, tr N) // The function which validates the invariants of the wrapped tree
+	t     *testing.T               // The test which validates the tree
+}
+
+// NewCheckedTree returns a new tree which wraps the given tree and validates its invariants with the given function after every mutation
+// The validated tree can be handed to all tree tests, so that implementations only have to define their invariants.
+func NewCheckedTree[T any, N orderedNavigableTree[T]](t *testing.T, tr N, check func(t *testing.T, tr N)) *checkedTree[T, N] {
+	return &checkedTree[T, N]{
+		orderedNavigableTree: tr,
+
+		tree:  tr,
+		check: check,
+		t:     t,
+	}
+}
+
+// Clear resets the wrapped tree and validates it
+func (c *checkedTree[T, N]) Clear() {
+	c.tree.Clear()
+	c.check(c.t, c.tree)
+}
+
+// Copy returns a validated copy of the wrapped tree
+func (c *checkedTree[T, N]) Copy() Tree[T] {
+	n := c.tree.Copy().(N)
+	c.check(c.t, n)
+
+	return NewCheckedTree(c.t, n, c.check)
+}
+
+// Set sets the value of the wrapped tree and validates it
+func (c *checkedTree[T, N]) Set(id T, v T) bool {
+	ok := c.tree.Set(id, v)
+	c.check(c.t, c.tree)
+
+	return ok
+}
+
+// SetFunc sets the value of the wrapped tree and validates it
+func (c *checkedTree[T, N]) SetFunc(m func(v T) bool, v T) bool {
+	ok := c.tree.SetFunc(m, v)
+	c.check(c.t, c.tree)
+
+	return ok
+}
+
+// Insert inserts the value into the wrapped tree and validates it
+func (c *checkedTree[T, N]) Insert(v T) {
+	c.tree.Insert(v)
+	c.check(c.t, c.tree)
+}
+
+// Remove removes the value from the wrapped tree and validates it
+func (c *checkedTree[T, N]) Remove(id T) (T, bool) {
+	v, ok := c.tree.Remove(id)
+	c.check(c.t, c.tree)
+
+	return v, ok
+}
+
+// Pop removes the last value of the wrapped tree and validates it
+func (c *checkedTree[T, N]) Pop() (T, bool) {
+	v, ok := c.tree.Pop()
+	c.check(c.t, c.tree)
+
+	return v, ok
+}
+
+// Shift removes the first value of the wrapped tree and validates it
+func (c *checkedTree[T, N]) Shift() (T, bool) {
+	v, ok := c.tree.Shift()
+	c.check(c.t, c.tree)
+
+	return v, ok
+}
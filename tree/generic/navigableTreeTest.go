@@ -0,0 +1,275 @@
+package generic
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+// NavigableTreeTest is the base for all bound query and seekable iterator tests of trees holding int values
+type NavigableTreeTest struct {
+	New func(t *testing.T) NavigableTree[int]
+}
+
+// Run executes all bound query and seekable iterator tests
+func (nt *NavigableTreeTest) Run(t *testing.T) {
+	nt.TestEmpty(t)
+	nt.TestBasic(t)
+	nt.TestDuplicates(t)
+	nt.TestIterDirections(t)
+	nt.TestRandomOperations(t)
+}
+
+// iterForward collects the values of the iterator until its end
+func iterForward(iter Iterator[int]) []int {
+	r := []int{}
+
+	for ; iter != nil; iter = iter.Next() {
+		r = append(r, iter.Get())
+	}
+
+	return r
+}
+
+// CompareWithSlice checks all bound queries and seekable iterators of the given tree against the given sorted slice
+func (nt *NavigableTreeTest) CompareWithSlice(t *testing.T, tr NavigableTree[int], sorted []int) {
+	Equal(t, tr.Len(), len(sorted))
+
+	if len(sorted) == 0 {
+		for _, f := range []func(id int) (int, bool){tr.Floor, tr.Ceiling, tr.Lower, tr.Higher} {
+			_, ok := f(0)
+			False(t, ok)
+		}
+
+		Nil(t, tr.IterFrom(0))
+		Nil(t, tr.IterRange(-10, 10))
+
+		return
+	}
+
+	lower := func(v int) int {
+		return sort.SearchInts(sorted, v)
+	}
+	upper := func(v int) int {
+		return sort.SearchInts(sorted, v+1)
+	}
+
+	from, to := sorted[0]-1, sorted[len(sorted)-1]+1
+
+	for v := from; v <= to; v++ {
+		f, ok := tr.Floor(v)
+		if i := upper(v); i > 0 {
+			True(t, ok)
+			Equal(t, f, sorted[i-1])
+		} else {
+			False(t, ok)
+		}
+
+		c, ok := tr.Ceiling(v)
+		if i := lower(v); i < len(sorted) {
+			True(t, ok)
+			Equal(t, c, sorted[i])
+		} else {
+			False(t, ok)
+		}
+
+		l, ok := tr.Lower(v)
+		if i := lower(v); i > 0 {
+			True(t, ok)
+			Equal(t, l, sorted[i-1])
+		} else {
+			False(t, ok)
+		}
+
+		h, ok := tr.Higher(v)
+		if i := upper(v); i < len(sorted) {
+			True(t, ok)
+			Equal(t, h, sorted[i])
+		} else {
+			False(t, ok)
+		}
+
+		iter := tr.IterFrom(v)
+		if i := lower(v); i < len(sorted) {
+			NotNil(t, iter)
+			Equal(t, iterForward(iter), sorted[i:])
+		} else {
+			Nil(t, iter)
+		}
+	}
+
+	for lo := from; lo <= to; lo++ {
+		for hi := lo - 1; hi <= to; hi++ {
+			iter := tr.IterRange(lo, hi)
+
+			if lo > hi || lower(lo) >= upper(hi) {
+				Nil(t, iter)
+
+				continue
+			}
+
+			NotNil(t, iter)
+			Equal(t, iterForward(iter), sorted[lower(lo):upper(hi)])
+		}
+	}
+}
+
+// TestEmpty tests bound queries and seekable iterators on an empty tree
+func (nt *NavigableTreeTest) TestEmpty(t *testing.T) {
+	tr := nt.New(t)
+
+	nt.CompareWithSlice(t, tr, []int{})
+}
+
+// TestBasic tests bound queries and seekable iterators with V
+func (nt *NavigableTreeTest) TestBasic(t *testing.T) {
+	tr := nt.New(t)
+
+	for _, v := range VRaw {
+		tr.Insert(v)
+	}
+
+	nt.CompareWithSlice(t, tr, V)
+
+	v, ok := tr.Floor(V[0] - 1)
+	False(t, ok)
+	Equal(t, v, 0)
+	v, ok = tr.Floor(V[0])
+	True(t, ok)
+	Equal(t, v, V[0])
+	v, ok = tr.Ceiling(V[VLen-1])
+	True(t, ok)
+	Equal(t, v, V[VLen-1])
+	v, ok = tr.Ceiling(V[VLen-1] + 1)
+	False(t, ok)
+	Equal(t, v, 0)
+	v, ok = tr.Lower(V[0])
+	False(t, ok)
+	Equal(t, v, 0)
+	v, ok = tr.Higher(V[VLen-1])
+	False(t, ok)
+	Equal(t, v, 0)
+
+	Equal(t, iterForward(tr.IterRange(V[0], V[VLen-1])), V)
+
+	tr.Shift()
+	tr.Pop()
+
+	nt.CompareWithSlice(t, tr, V[1:VLen-1])
+
+	tr.Clear()
+
+	nt.CompareWithSlice(t, tr, []int{})
+}
+
+// TestDuplicates tests bound queries and seekable iterators with duplicated values
+func (nt *NavigableTreeTest) TestDuplicates(t *testing.T) {
+	tr := nt.New(t)
+
+	for _, v := range []int{3, 1, 3, 2, 3, 1, 5} {
+		tr.Insert(v)
+	}
+
+	nt.CompareWithSlice(t, tr, []int{1, 1, 2, 3, 3, 3, 5})
+
+	Equal(t, iterForward(tr.IterFrom(3)), []int{3, 3, 3, 5})
+	Equal(t, iterForward(tr.IterRange(3, 4)), []int{3, 3, 3})
+
+	c := tr.Copy().(NavigableTree[int])
+
+	nt.CompareWithSlice(t, c, []int{1, 1, 2, 3, 3, 3, 5})
+}
+
+// TestIterDirections tests seekable iterators which change their direction in the middle of the tree
+func (nt *NavigableTreeTest) TestIterDirections(t *testing.T) {
+	tr := nt.New(t)
+
+	for i := 0; i < 20; i++ {
+		tr.Insert(i * 10)
+	}
+
+	// IterFrom is not bounded in either direction
+	iter := tr.IterFrom(95)
+	Equal(t, iter.Get(), 100)
+
+	r := []int{}
+	for i := iter; i != nil; i = i.Previous() {
+		r = append(r, i.Get())
+	}
+	Equal(t, r, []int{100, 90, 80, 70, 60, 50, 40, 30, 20, 10, 0})
+
+	iter = tr.IterFrom(100)
+	Equal(t, iter.Next().Get(), 110)
+	Equal(t, iter.Previous().Get(), 100)
+	Equal(t, iter.Previous().Get(), 90)
+	Equal(t, iter.Next().Get(), 100)
+
+	// IterRange is bounded in both directions
+	iter = tr.IterRange(41, 79)
+	Equal(t, iter.Get(), 50)
+	Nil(t, tr.IterRange(41, 79).Previous())
+
+	Equal(t, iter.Next().Get(), 60)
+	Equal(t, iter.Next().Get(), 70)
+	Equal(t, iter.Previous().Get(), 60)
+	Equal(t, iter.Next().Get(), 70)
+	Nil(t, iter.Next())
+
+	iter = tr.IterRange(50, 70)
+	iter.Next()
+	iter.Next()
+	r = []int{}
+	for i := iter; i != nil; i = i.Previous() {
+		r = append(r, i.Get())
+	}
+	Equal(t, r, []int{70, 60, 50})
+
+	iter = tr.IterRange(50, 50)
+	Equal(t, iter.Get(), 50)
+	Nil(t, tr.IterRange(50, 50).Next())
+	Nil(t, tr.IterRange(50, 50).Previous())
+
+	Nil(t, tr.IterRange(51, 59))
+	Nil(t, tr.IterRange(60, 50))
+	Nil(t, tr.IterRange(191, 300))
+	Nil(t, tr.IterFrom(191))
+}
+
+// TestRandomOperations tests bound queries and seekable iterators after random mutations
+func (nt *NavigableTreeTest) TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	tr := nt.New(t)
+	var sorted []int
+
+	for i := 0; i < 300; i++ {
+		v := r.Intn(50)
+
+		switch r.Intn(4) {
+		case 0, 1:
+			tr.Insert(v)
+
+			sorted = append(sorted, v)
+		case 2:
+			if _, ok := tr.Remove(v); ok {
+				i := sort.SearchInts(sorted, v)
+
+				sorted = append(sorted[:i], sorted[i+1:]...)
+			}
+		case 3:
+			if _, ok := tr.Pop(); ok {
+				sorted = sorted[:len(sorted)-1]
+			}
+		}
+
+		sort.Ints(sorted)
+
+		if i%10 == 0 {
+			nt.CompareWithSlice(t, tr, sorted)
+		}
+	}
+
+	nt.CompareWithSlice(t, tr, sorted)
+}
@@ -0,0 +1,174 @@
+package generic
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+)
+
+// OrderedTreeTest is the base for all order statistic tests of trees holding int values
+type OrderedTreeTest struct {
+	New func(t *testing.T) OrderedTree[int]
+}
+
+// Run executes all order statistic tests
+func (ot *OrderedTreeTest) Run(t *testing.T) {
+	ot.TestEmpty(t)
+	ot.TestBasic(t)
+	ot.TestDuplicates(t)
+	ot.TestRandomOperations(t)
+}
+
+// CompareWithSlice checks all order statistic queries of the given tree against the given sorted slice
+func (ot *OrderedTreeTest) CompareWithSlice(t *testing.T, tr OrderedTree[int], sorted []int) {
+	Equal(t, tr.Len(), len(sorted))
+
+	for k, v := range sorted {
+		s, ok := tr.Select(k)
+		True(t, ok)
+		Equal(t, s, v)
+	}
+
+	_, ok := tr.Select(-1)
+	False(t, ok)
+	_, ok = tr.Select(len(sorted))
+	False(t, ok)
+
+	if len(sorted) == 0 {
+		Equal(t, tr.Rank(0), 0)
+		Equal(t, tr.CountRange(-10, 10), 0)
+
+		return
+	}
+
+	lower := func(v int) int {
+		return sort.SearchInts(sorted, v)
+	}
+	upper := func(v int) int {
+		return sort.SearchInts(sorted, v+1)
+	}
+
+	from, to := sorted[0]-1, sorted[len(sorted)-1]+1
+
+	for v := from; v <= to; v++ {
+		Equal(t, tr.Rank(v), lower(v))
+	}
+
+	for lo := from; lo <= to; lo++ {
+		for hi := lo - 1; hi <= to; hi++ {
+			expected := 0
+			if lo <= hi {
+				expected = upper(hi) - lower(lo)
+			}
+
+			Equal(t, tr.CountRange(lo, hi), expected)
+		}
+	}
+}
+
+// TestEmpty tests order statistic queries on an empty tree
+func (ot *OrderedTreeTest) TestEmpty(t *testing.T) {
+	tr := ot.New(t)
+
+	ot.CompareWithSlice(t, tr, []int{})
+}
+
+// TestBasic tests order statistic queries with V
+func (ot *OrderedTreeTest) TestBasic(t *testing.T) {
+	tr := ot.New(t)
+
+	for _, v := range VRaw {
+		tr.Insert(v)
+	}
+
+	ot.CompareWithSlice(t, tr, V)
+
+	Equal(t, tr.Rank(V[0]), 0)
+	Equal(t, tr.Rank(V[VLen-1]+1), VLen)
+	Equal(t, tr.CountRange(V[0], V[VLen-1]), VLen)
+	Equal(t, tr.CountRange(V[VLen-1], V[0]), 0)
+
+	v, ok := tr.Select(0)
+	True(t, ok)
+	Equal(t, v, V[0])
+	v, ok = tr.Select(VLen - 1)
+	True(t, ok)
+	Equal(t, v, V[VLen-1])
+
+	tr.Shift()
+	tr.Pop()
+
+	ot.CompareWithSlice(t, tr, V[1:VLen-1])
+
+	tr.Clear()
+
+	ot.CompareWithSlice(t, tr, []int{})
+}
+
+// TestDuplicates tests order statistic queries with duplicated values
+func (ot *OrderedTreeTest) TestDuplicates(t *testing.T) {
+	tr := ot.New(t)
+
+	for _, v := range []int{3, 1, 3, 2, 3, 1, 5} {
+		tr.Insert(v)
+	}
+
+	ot.CompareWithSlice(t, tr, []int{1, 1, 2, 3, 3, 3, 5})
+
+	Equal(t, tr.Rank(3), 3)
+	Equal(t, tr.CountRange(3, 3), 3)
+	Equal(t, tr.CountRange(4, 4), 0)
+
+	tr.Remove(3)
+
+	ot.CompareWithSlice(t, tr, []int{1, 1, 2, 3, 3, 5})
+
+	c := tr.Copy().(OrderedTree[int])
+
+	ot.CompareWithSlice(t, c, []int{1, 1, 2, 3, 3, 5})
+}
+
+// TestRandomOperations tests order statistic queries after random mutations
+func (ot *OrderedTreeTest) TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	tr := ot.New(t)
+	var sorted []int
+
+	for i := 0; i < 300; i++ {
+		v := r.Intn(50)
+
+		switch r.Intn(5) {
+		case 0, 1:
+			tr.Insert(v)
+
+			sorted = append(sorted, v)
+		case 2:
+			if _, ok := tr.Remove(v); ok {
+				i := sort.SearchInts(sorted, v)
+
+				sorted = append(sorted[:i], sorted[i+1:]...)
+			}
+		case 3:
+			if _, ok := tr.Shift(); ok {
+				sorted = sorted[1:]
+			}
+		case 4:
+			if tr.Set(v, v+1) {
+				i := sort.SearchInts(sorted, v)
+
+				sorted[i] = v + 1
+			}
+		}
+
+		sort.Ints(sorted)
+
+		if i%10 == 0 {
+			ot.CompareWithSlice(t, tr, sorted)
+		}
+	}
+
+	ot.CompareWithSlice(t, tr, sorted)
+}
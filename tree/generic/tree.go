@@ -0,0 +1,113 @@
+package generic
+
+import (
+	"context"
+	"iter"
+)
+
+// Iterator defines a tree iterator over values of type T
+type Iterator[T any] interface {
+	// Next iterates to the next node in the tree and returns the iterator, or nil if there is no next node
+	Next() Iterator[T]
+	// Previous iterates to the previous node in the tree and returns the iterator, or nil if there is no previous node
+	Previous() Iterator[T]
+
+	// Get returns the value of the iterator's current node
+	Get() T
+}
+
+// Tree defines a tree holding values of type T
+// Trees consists of nodes which are not exposed to the user. Only the values of each node is exposed.
+// Trees are sorted by a compare function which also helps to identify nodes in the tree. This compare function makes use of the values of each node.
+type Tree[T any] interface {
+	// Clear resets the tree to zero nodes and resets the tree's meta data
+	Clear()
+	// Len returns the current node count
+	Len() int
+	// Empty returns true if the current node count is zero
+	Empty() bool
+
+	// Chan returns a channel which iterates from the front to the back of the tree
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanContext to stop early.
+	Chan(n int) <-chan T
+	// ChanBack returns a channel which iterates from the back to the front of the tree
+	// The channel is buffered with n values. The channel has to be drained or else its goroutine leaks, use ChanBackContext to stop early.
+	ChanBack(n int) <-chan T
+	// ChanContext returns a channel which iterates from the front to the back of the tree and which is closed early if the given context is done
+	ChanContext(ctx context.Context, n int) <-chan T
+	// ChanBackContext returns a channel which iterates from the back to the front of the tree and which is closed early if the given context is done
+	ChanBackContext(ctx context.Context, n int) <-chan T
+
+	// Iter returns an iterator which starts at the front of the tree, or nil if there are no nodes in the tree
+	Iter() Iterator[T]
+	// IterBack returns an iterator which starts at the back of the tree, or nil if there are no nodes in the tree
+	IterBack() Iterator[T]
+
+	// All returns a sequence which iterates from the front to the back of the tree
+	All() iter.Seq[T]
+	// Backward returns a sequence which iterates from the back to the front of the tree
+	Backward() iter.Seq[T]
+
+	// First returns the first value of the tree and true, or false if there is no value
+	First() (T, bool)
+	// Last returns the last value of the tree and true, or false if there is no value
+	Last() (T, bool)
+	// Get returns the value of the node identified by the given id value and true, or false if there is no such node
+	Get(id T) (T, bool)
+	// GetFunc returns the value of the first node selected by the given function and true, or false if there is no such node
+	GetFunc(m func(v T) bool) (T, bool)
+	// Set sets the value of the node identified by the given id value and returns true, or false if there is no such node
+	Set(id T, v T) bool
+	// SetFunc sets the value of the first node selected by the given function and returns true, or false if there is no such node
+	SetFunc(m func(v T) bool, v T) bool
+
+	// Contains returns true if a node identified by the given id value exists in the tree, or false if it does not
+	Contains(id T) bool
+
+	// Copy returns an exact copy of the tree
+	Copy() Tree[T]
+	// Slice returns a copy of the tree as a slice
+	Slice() []T
+
+	// Insert inserts a new node into the tree with the given value
+	Insert(v T)
+	// Remove removes the node identified by the given id value and returns its value and true, or false if there is no such node
+	Remove(id T) (T, bool)
+	// Pop removes the last node and returns its value and true, or false if there is no such node
+	Pop() (T, bool)
+	// Shift removes the first node and returns its value and true, or false if there is no such node
+	Shift() (T, bool)
+}
+
+// OrderedTree defines a tree which additionally supports order statistic queries
+// All queries are done in O(log n) for balanced trees by maintaining the size of every subtree.
+type OrderedTree[T any] interface {
+	Tree[T]
+
+	// Select returns the value with the given zero based rank in the tree order and true, or false if the rank is out of range
+	Select(k int) (T, bool)
+	// Rank returns the count of values in the tree which are less than the given id value
+	Rank(id T) int
+	// CountRange returns the count of values in the tree which are greater than or equal to lo and less than or equal to hi
+	CountRange(lo, hi T) int
+}
+
+// NavigableTree defines a tree which additionally supports bound queries and iterators which start in the middle of the tree
+// Bound queries and the positioning of iterators are done in O(log n) for balanced trees.
+type NavigableTree[T any] interface {
+	Tree[T]
+
+	// Floor returns the greatest value in the tree which is less than or equal to the given id value and true, or false if there is no such value
+	Floor(id T) (T, bool)
+	// Ceiling returns the least value in the tree which is greater than or equal to the given id value and true, or false if there is no such value
+	Ceiling(id T) (T, bool)
+	// Lower returns the greatest value in the tree which is less than the given id value and true, or false if there is no such value
+	Lower(id T) (T, bool)
+	// Higher returns the least value in the tree which is greater than the given id value and true, or false if there is no such value
+	Higher(id T) (T, bool)
+
+	// IterFrom returns an iterator which starts at the least value greater than or equal to the given id value, or nil if there is no such value
+	IterFrom(id T) Iterator[T]
+	// IterRange returns an iterator which starts at the least value greater than or equal to lo and which only moves over values between lo and hi inclusively, or nil if there are no such values
+	IterRange(lo, hi T) Iterator[T]
+}
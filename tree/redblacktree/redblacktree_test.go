@@ -0,0 +1,108 @@
+package redblacktree
+
+import (
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// checkInvariants validates the parent links, the order, the subtree sizes and the red-black properties of the tree
+func checkInvariants[T any](t *testing.T, tr *tree[T]) {
+	if tr.root != nil {
+		Nil(t, tr.root.parent)
+		False(t, tr.root.red, "root is not black")
+	}
+
+	count := 0
+
+	// check returns the black height of the given subtree
+	var check func(c *node[T]) int
+	check = func(c *node[T]) int {
+		if c == nil {
+			return 1
+		}
+
+		count++
+
+		Equal(t, c.size, size(c.left)+size(c.right)+1, "subtree size is wrong")
+
+		if c.left != nil {
+			Equal(t, c.left.parent, c)
+			True(t, tr.compare(c.left.value, c.value) <= 0)
+		}
+		if c.right != nil {
+			Equal(t, c.right.parent, c)
+			True(t, tr.compare(c.right.value, c.value) >= 0)
+		}
+
+		if c.red {
+			False(t, isRed(c.left) || isRed(c.right), "red node has a red child")
+		}
+
+		bl := check(c.left)
+		br := check(c.right)
+		Equal(t, bl, br, "black heights differ")
+
+		if !c.red {
+			bl++
+		}
+
+		return bl
+	}
+
+	check(tr.root)
+
+	Equal(t, count, tr.len)
+
+	var p *node[T]
+	for c := tr.getFirstNode(); c != nil; c = c.next() {
+		if p != nil {
+			True(t, tr.compare(p.value, c.value) <= 0)
+		}
+
+		p = c
+	}
+}
+
+func TestRunAllTests(t *testing.T) {
+	tt := &Tree.TreeTest{
+		New: func(t *testing.T) Tree.Tree {
+			return GenericTree.NewCheckedTree(t, New(Tree.CompareInts), checkInvariants[interface{}])
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllOrderedTreeTests(t *testing.T) {
+	ot := &GenericTree.OrderedTreeTest{
+		New: func(t *testing.T) GenericTree.OrderedTree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	ot.Run(t)
+}
+
+func TestRunAllNavigableTreeTests(t *testing.T) {
+	nt := &GenericTree.NavigableTreeTest{
+		New: func(t *testing.T) GenericTree.NavigableTree[int] {
+			return GenericTree.NewCheckedTree(t, NewOrdered[int](), checkInvariants[int])
+		},
+	}
+
+	nt.Run(t)
+}
@@ -0,0 +1,636 @@
+package skiplist
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"math/rand"
+
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// maxLevel is the maximum level count of a node which suffices for 4^maxLevel nodes
+const maxLevel = 32
+
+// node holds a single node of a skip list
+type node[T any] struct {
+	next     []*node[T] // The next node on every level of this node
+	previous *node[T]   // The node before this node on the lowest level
+	value    T          // The value stored with this node
+}
+
+// iterator holds the iterator for a skip list
+type iterator[T any] struct {
+	current *node[T] // The current node in traversal
+	first   *node[T] // The first node of the traversal range, or nil if the range is not bounded at the front
+	last    *node[T] // The last node of the traversal range, or nil if the range is not bounded at the back
+}
+
+// Next iterates to the next node in the tree and returns the iterator, or nil if there is no next node
+func (iter *iterator[T]) Next() GenericTree.Iterator[T] {
+	if iter.current != nil {
+		if iter.current == iter.last {
+			iter.current = nil
+		} else {
+			iter.current = iter.current.next[0]
+		}
+	}
+
+	if iter.current == nil {
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous node in the tree and returns the iterator, or nil if there is no previous node
+func (iter *iterator[T]) Previous() GenericTree.Iterator[T] {
+	if iter.current != nil {
+		if iter.current == iter.first {
+			iter.current = nil
+		} else {
+			iter.current = iter.current.previous
+		}
+	}
+
+	if iter.current == nil {
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current node
+func (iter *iterator[T]) Get() T {
+	return iter.current.value
+}
+
+// tree holds a skip list which implements the tree interface
+// Every node is part of the lowest level which is a sorted doubly linked list. Each level above holds about a quarter of the nodes of the level below, which makes searches, insertions and removals O(log n) on average.
+type tree[T any] struct {
+	head    *node[T]         // The sentinel node in front of all nodes which has the maximum level count
+	last    *node[T]         // The last node of the lowest level
+	level   int              // The level count which is currently used by the nodes
+	len     int              // The current node count
+	compare func(a, b T) int // Compare two values for the tree node order
+	random  *rand.Rand       // The random source for the level counts of new nodes
+}
+
+// Option defines an option for creating a skip list
+type Option[T any] func(t *tree[T])
+
+// WithSource sets the random source for the level counts of new nodes, which allows deterministic skip lists
+func WithSource[T any](src rand.Source) Option[T] {
+	return func(t *tree[T]) {
+		t.random = rand.New(src)
+	}
+}
+
+// New returns a new skip list
+func New[T any](compare func(a, b T) int, opts ...Option[T]) *tree[T] {
+	t := new(tree[T])
+
+	t.compare = compare
+
+	for _, o := range opts {
+		o(t)
+	}
+
+	if t.random == nil {
+		t.random = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	t.Clear()
+
+	return t
+}
+
+// NewOrdered returns a new skip list for ordered values which are compared with cmp.Compare
+func NewOrdered[T cmp.Ordered](opts ...Option[T]) *tree[T] {
+	return New(cmp.Compare[T], opts...)
+}
+
+// Clear resets the tree to zero nodes and resets the tree's meta data
+func (t *tree[T]) Clear() {
+	t.head = &node[T]{
+		next: make([]*node[T], maxLevel),
+	}
+	t.last = nil
+	t.level = 1
+	t.len = 0
+}
+
+// Len returns the current node count
+func (t *tree[T]) Len() int {
+	return t.len
+}
+
+// Empty returns true if the current node count is zero
+func (t *tree[T]) Empty() bool {
+	return t.len == 0
+}
+
+// randomLevel returns a random level count for a new node where every additional level has a probability of one quarter
+func (t *tree[T]) randomLevel() int {
+	l := 1
+
+	for l < maxLevel && t.random.Intn(4) == 0 {
+		l++
+	}
+
+	return l
+}
+
+// search returns the last node on the lowest level for which the given function returns true, or the head if there is no such node
+// The given function must return true for a prefix of the nodes in the tree order.
+func (t *tree[T]) search(before func(v T) bool) *node[T] {
+	c := t.head
+
+	for l := t.level - 1; l > -1; l-- {
+		for c.next[l] != nil && before(c.next[l].value) {
+			c = c.next[l]
+		}
+	}
+
+	return c
+}
+
+// nodeOrNil returns the given node, or nil if it is the head
+func (t *tree[T]) nodeOrNil(c *node[T]) *node[T] {
+	if c == t.head {
+		return nil
+	}
+
+	return c
+}
+
+// getNode returns the first node identified by the given id value, or nil if there is no such node
+func (t *tree[T]) getNode(id T) *node[T] {
+	c := t.getCeilingNode(id)
+
+	if c == nil || t.compare(id, c.value) != 0 {
+		return nil
+	}
+
+	return c
+}
+
+// getNodeFunc returns the first node selected by the given function, or nil if there is no such node
+func (t *tree[T]) getNodeFunc(m func(v T) bool) *node[T] {
+	for c := t.head.next[0]; c != nil; c = c.next[0] {
+		if m(c.value) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// getFirstNode returns the node with the first value of the tree
+func (t *tree[T]) getFirstNode() *node[T] {
+	return t.head.next[0]
+}
+
+// getLastNode returns the node with the last value of the tree
+func (t *tree[T]) getLastNode() *node[T] {
+	return t.last
+}
+
+// getFloorNode returns the last node in the tree order with a value less than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getFloorNode(id T) *node[T] {
+	return t.nodeOrNil(t.search(func(v T) bool {
+		return t.compare(v, id) <= 0
+	}))
+}
+
+// getCeilingNode returns the first node in the tree order with a value greater than or equal to the given id value, or nil if there is no such node
+func (t *tree[T]) getCeilingNode(id T) *node[T] {
+	return t.search(func(v T) bool {
+		return t.compare(v, id) < 0
+	}).next[0]
+}
+
+// getLowerNode returns the last node in the tree order with a value less than the given id value, or nil if there is no such node
+func (t *tree[T]) getLowerNode(id T) *node[T] {
+	return t.nodeOrNil(t.search(func(v T) bool {
+		return t.compare(v, id) < 0
+	}))
+}
+
+// getHigherNode returns the first node in the tree order with a value greater than the given id value, or nil if there is no such node
+func (t *tree[T]) getHigherNode(id T) *node[T] {
+	return t.search(func(v T) bool {
+		return t.compare(v, id) <= 0
+	}).next[0]
+}
+
+// insert creates a new node with the given value and adds it after all nodes with equal values
+func (t *tree[T]) insert(v T) *node[T] {
+	var update [maxLevel]*node[T]
+
+	c := t.head
+
+	for l := t.level - 1; l > -1; l-- {
+		for c.next[l] != nil && t.compare(c.next[l].value, v) <= 0 {
+			c = c.next[l]
+		}
+
+		update[l] = c
+	}
+
+	level := t.randomLevel()
+
+	for ; t.level < level; t.level++ {
+		update[t.level] = t.head
+	}
+
+	n := &node[T]{
+		next:  make([]*node[T], level),
+		value: v,
+	}
+
+	for l := 0; l < level; l++ {
+		n.next[l] = update[l].next[l]
+		update[l].next[l] = n
+	}
+
+	n.previous = t.nodeOrNil(update[0])
+
+	if n.next[0] != nil {
+		n.next[0].previous = n
+	} else {
+		t.last = n
+	}
+
+	t.len++
+
+	return n
+}
+
+// removeNode removes the given node from the tree
+func (t *tree[T]) removeNode(n *node[T]) T {
+	c := t.head
+
+	for l := t.level - 1; l > -1; l-- {
+		for c.next[l] != nil && t.compare(c.next[l].value, n.value) < 0 {
+			c = c.next[l]
+		}
+
+		if l < len(n.next) {
+			// the node can be behind nodes with equal values
+			p := c
+			for p.next[l] != n {
+				p = p.next[l]
+			}
+
+			p.next[l] = n.next[l]
+		}
+	}
+
+	if n.next[0] != nil {
+		n.next[0].previous = n.previous
+	} else {
+		t.last = n.previous
+	}
+
+	for t.level > 1 && t.head.next[t.level-1] == nil {
+		t.level--
+	}
+
+	t.len--
+
+	n.next = nil
+	n.previous = nil
+
+	return n.value
+}
+
+// Chan returns a channel which iterates from the front to the back of the tree
+func (t *tree[T]) Chan(n int) <-chan T {
+	return t.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of the tree
+func (t *tree[T]) ChanBack(n int) <-chan T {
+	return t.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := t.Iter(); iter != nil; iter = iter.Next() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+
+	go func() {
+		defer close(ch)
+
+		for iter := t.IterBack(); iter != nil; iter = iter.Previous() {
+			select {
+			case ch <- iter.Get():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) Iter() GenericTree.Iterator[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: t.getFirstNode(),
+	}
+}
+
+// IterBack returns an iterator which starts at the back of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) IterBack() GenericTree.Iterator[T] {
+	if t.len == 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: t.getLastNode(),
+	}
+}
+
+// All returns a sequence which iterates from the front to the back of the tree
+func (t *tree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.Iter(); it != nil; it = it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of the tree
+func (t *tree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := t.IterBack(); it != nil; it = it.Previous() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the tree and true, or false if there is no value
+func (t *tree[T]) First() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	n := t.getFirstNode()
+
+	return n.value, true
+}
+
+// Last returns the last value of the tree and true, or false if there is no value
+func (t *tree[T]) Last() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	n := t.getLastNode()
+
+	return n.value, true
+}
+
+// Get returns the value of the node identified by the given id value and true, or false if there is no such node
+func (t *tree[T]) Get(id T) (T, bool) {
+	n := t.getNode(id)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// GetFunc returns the value of the first node selected by the given function and true, or false if there is no such node
+func (t *tree[T]) GetFunc(m func(v T) bool) (T, bool) {
+	n := t.getNodeFunc(m)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// Set sets the value of the node identified by the given id value and returns true, or false if there is no such node
+func (t *tree[T]) Set(id T, v T) bool {
+	n := t.getNode(id)
+
+	if n == nil {
+		return false
+	}
+
+	t.removeNode(n)
+
+	t.Insert(v)
+
+	return true
+}
+
+// SetFunc sets the value of the first node selected by the given function and returns true, or false if there is no such node
+func (t *tree[T]) SetFunc(m func(v T) bool, v T) bool {
+	n := t.getNodeFunc(m)
+
+	if n == nil {
+		return false
+	}
+
+	t.removeNode(n)
+
+	t.Insert(v)
+
+	return true
+}
+
+// Contains returns true if a node identified by the given id value exists in the tree, or false if it does not
+func (t *tree[T]) Contains(id T) bool {
+	return t.getNode(id) != nil
+}
+
+// Copy returns an exact copy of the tree
+// The nodes of the copy have the same level counts as the nodes of the tree, so the random source of the tree is not used and the copy gets its own random source.
+func (t *tree[T]) Copy() GenericTree.Tree[T] {
+	t2 := New(t.compare)
+
+	var tails [maxLevel]*node[T]
+
+	for l := range tails {
+		tails[l] = t2.head
+	}
+
+	for c := t.head.next[0]; c != nil; c = c.next[0] {
+		n := &node[T]{
+			next:     make([]*node[T], len(c.next)),
+			previous: t2.last,
+			value:    c.value,
+		}
+
+		for l := range n.next {
+			tails[l].next[l] = n
+			tails[l] = n
+		}
+
+		t2.last = n
+	}
+
+	t2.level = t.level
+	t2.len = t.len
+
+	return t2
+}
+
+// Slice returns a copy of the tree as a slice
+func (t *tree[T]) Slice() []T {
+	a := make([]T, t.len)
+
+	j := 0
+
+	for iter := t.Iter(); iter != nil; iter = iter.Next() {
+		a[j] = iter.Get()
+
+		j++
+	}
+
+	return a
+}
+
+// Insert inserts a new node into the tree with the given value
+func (t *tree[T]) Insert(v T) {
+	t.insert(v)
+}
+
+// Remove removes the node identified by the given id value and returns its value and true, or false if there is no such node
+func (t *tree[T]) Remove(id T) (T, bool) {
+	n := t.getNode(id)
+
+	if n == nil {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(n), true
+}
+
+// Pop removes the last node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Pop() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(t.getLastNode()), true
+}
+
+// Shift removes the first node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Shift() (T, bool) {
+	if t.len == 0 {
+		var v T
+
+		return v, false
+	}
+
+	return t.removeNode(t.getFirstNode()), true
+}
+
+// nodeValue returns the value of the given node and true, or false if the node is nil
+func nodeValue[T any](c *node[T]) (T, bool) {
+	if c == nil {
+		var v T
+
+		return v, false
+	}
+
+	return c.value, true
+}
+
+// Floor returns the greatest value in the tree which is less than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Floor(id T) (T, bool) {
+	return nodeValue(t.getFloorNode(id))
+}
+
+// Ceiling returns the least value in the tree which is greater than or equal to the given id value and true, or false if there is no such value
+func (t *tree[T]) Ceiling(id T) (T, bool) {
+	return nodeValue(t.getCeilingNode(id))
+}
+
+// Lower returns the greatest value in the tree which is less than the given id value and true, or false if there is no such value
+func (t *tree[T]) Lower(id T) (T, bool) {
+	return nodeValue(t.getLowerNode(id))
+}
+
+// Higher returns the least value in the tree which is greater than the given id value and true, or false if there is no such value
+func (t *tree[T]) Higher(id T) (T, bool) {
+	return nodeValue(t.getHigherNode(id))
+}
+
+// IterFrom returns an iterator which starts at the least value greater than or equal to the given id value, or nil if there is no such value
+func (t *tree[T]) IterFrom(id T) GenericTree.Iterator[T] {
+	c := t.getCeilingNode(id)
+
+	if c == nil {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: c,
+	}
+}
+
+// IterRange returns an iterator which starts at the least value greater than or equal to lo and which only moves over values between lo and hi inclusively, or nil if there are no such values
+func (t *tree[T]) IterRange(lo, hi T) GenericTree.Iterator[T] {
+	if t.compare(lo, hi) > 0 {
+		return nil
+	}
+
+	first := t.getCeilingNode(lo)
+	last := t.getFloorNode(hi)
+
+	if first == nil || last == nil || t.compare(first.value, last.value) > 0 {
+		return nil
+	}
+
+	return &iterator[T]{
+		current: first,
+		first:   first,
+		last:    last,
+	}
+}
@@ -0,0 +1,203 @@
+package skiplist
+
+import (
+	"math/rand"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// checkInvariants validates the order and the links of all levels of the tree
+func checkInvariants[T any](t *testing.T, tr *tree[T]) {
+	count := 0
+
+	var p *node[T]
+	for c := tr.head.next[0]; c != nil; c = c.next[0] {
+		Equal(t, c.previous, p)
+		True(t, len(c.next) <= tr.level)
+
+		if p != nil {
+			True(t, tr.compare(p.value, c.value) <= 0)
+		}
+
+		count++
+		p = c
+	}
+
+	Equal(t, tr.last, p)
+	Equal(t, count, tr.len)
+
+	// every level is a sorted sublist of the level below
+	for l := 1; l < maxLevel; l++ {
+		b := tr.head.next[l-1]
+
+		for c := tr.head.next[l]; c != nil; c = c.next[l] {
+			for b != c {
+				NotNil(t, b)
+
+				b = b.next[l-1]
+			}
+
+			True(t, len(c.next) > l)
+		}
+
+		if l >= tr.level {
+			Nil(t, tr.head.next[l])
+		}
+	}
+
+	if tr.level > 1 {
+		NotNil(t, tr.head.next[tr.level-1])
+	}
+}
+
+func TestRunAllTests(t *testing.T) {
+	tt := &Tree.TreeTest{
+		New: func(t *testing.T) Tree.Tree {
+			return New(func(a, b interface{}) int {
+				switch {
+				case a.(int) == b.(int):
+					return 0
+				case a.(int) < b.(int):
+					return -1
+				default:
+					return 1
+				}
+			})
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return NewOrdered(WithSource[int](rand.NewSource(1)))
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllNavigableTreeTests(t *testing.T) {
+	nt := &GenericTree.NavigableTreeTest{
+		New: func(t *testing.T) GenericTree.NavigableTree[int] {
+			return NewOrdered(WithSource[int](rand.NewSource(1)))
+		},
+	}
+
+	nt.Run(t)
+}
+
+func TestDeterministicLevels(t *testing.T) {
+	levels := func() []int {
+		tr := NewOrdered(WithSource[int](rand.NewSource(42)))
+
+		for i := 0; i < 1000; i++ {
+			tr.Insert(i)
+		}
+
+		var r []int
+		for c := tr.head.next[0]; c != nil; c = c.next[0] {
+			r = append(r, len(c.next))
+		}
+
+		return r
+	}
+
+	a := levels()
+
+	Equal(t, a, levels())
+
+	// about a quarter of the nodes of a level reach the next level
+	n := 0
+	for _, l := range a {
+		if l > 1 {
+			n++
+		}
+	}
+
+	True(t, n > 150 && n < 350)
+}
+
+func TestCopy(t *testing.T) {
+	tr := NewOrdered(WithSource[int](rand.NewSource(42)))
+	o := NewOrdered(WithSource[int](rand.NewSource(42)))
+
+	for i := 0; i < 1000; i++ {
+		tr.Insert(i)
+		o.Insert(i)
+	}
+
+	c := tr.Copy().(*tree[int])
+
+	checkInvariants(t, c)
+	Equal(t, c.Slice(), tr.Slice())
+	Equal(t, c.level, tr.level)
+
+	// the copy has the same levels without using the random source of the tree
+	for n, m := tr.head.next[0], c.head.next[0]; n != nil; n, m = n.next[0], m.next[0] {
+		Equal(t, len(m.next), len(n.next))
+	}
+
+	Equal(t, tr.random.Int63(), o.random.Int63())
+
+	c.Insert(1000)
+	c.Remove(0)
+
+	checkInvariants(t, c)
+	Equal(t, tr.Len(), 1000)
+	True(t, tr.Contains(0))
+	False(t, tr.Contains(1000))
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	tr := NewOrdered(WithSource[int](rand.NewSource(1)))
+	counts := make(map[int]int)
+
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(100)
+
+		switch r.Intn(5) {
+		case 0, 1:
+			tr.Insert(v)
+			counts[v]++
+		case 2:
+			_, ok := tr.Remove(v)
+			Equal(t, ok, counts[v] > 0)
+
+			if ok {
+				counts[v]--
+			}
+		case 3:
+			if w, ok := tr.Shift(); ok {
+				counts[w]--
+			}
+		case 4:
+			if w, ok := tr.Pop(); ok {
+				counts[w]--
+			}
+		}
+
+		checkInvariants(t, tr)
+	}
+
+	l := 0
+	for v, c := range counts {
+		l += c
+
+		Equal(t, tr.Contains(v), c > 0)
+	}
+	Equal(t, tr.Len(), l)
+
+	c := tr.Copy().(*tree[int])
+
+	checkInvariants(t, c)
+	Equal(t, c.Slice(), tr.Slice())
+}
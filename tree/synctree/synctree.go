@@ -0,0 +1,345 @@
+package synctree
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	Tree "github.com/zimmski/container/tree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// iterator holds a snapshot iterator for a thread-safe tree
+type iterator[T any] struct {
+	snapshot []T // The values of the tree at the time the iterator was created
+	i        int // The current index in the snapshot
+}
+
+// Next iterates to the next node in the snapshot and returns the iterator, or nil if there is no next node
+func (iter *iterator[T]) Next() GenericTree.Iterator[T] {
+	iter.i++
+
+	if iter.snapshot == nil || iter.i >= len(iter.snapshot) {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Previous iterates to the previous node in the snapshot and returns the iterator, or nil if there is no previous node
+func (iter *iterator[T]) Previous() GenericTree.Iterator[T] {
+	iter.i--
+
+	if iter.snapshot == nil || iter.i < 0 {
+		iter.snapshot = nil
+
+		return nil
+	}
+
+	return iter
+}
+
+// Get returns the value of the iterator's current node in the snapshot
+func (iter *iterator[T]) Get() T {
+	return iter.snapshot[iter.i]
+}
+
+// tree holds a tree which is safe for concurrent use
+// All methods lock the wrapped tree for their whole duration. Iterators, channels and sequences work on a snapshot of the tree which is taken when they are created.
+type tree[T any] struct {
+	mutex sync.RWMutex        // The lock for the wrapped tree
+	tree  GenericTree.Tree[T] // The wrapped tree
+}
+
+// New returns a new thread-safe tree which wraps the given tree
+// The given tree must not be used directly afterwards.
+func New(t Tree.Tree) *tree[interface{}] {
+	return NewOf(t)
+}
+
+// NewOf returns a new thread-safe tree for values of type T which wraps the given tree
+// The given tree must not be used directly afterwards.
+func NewOf[T any](t GenericTree.Tree[T]) *tree[T] {
+	return &tree[T]{
+		tree: t,
+	}
+}
+
+// newIterator returns a new snapshot iterator starting at the given index, or nil if the snapshot has no such index
+func newIterator[T any](snapshot []T, i int) GenericTree.Iterator[T] {
+	if i < 0 || i >= len(snapshot) {
+		return nil
+	}
+
+	return &iterator[T]{
+		snapshot: snapshot,
+		i:        i,
+	}
+}
+
+// Clear resets the tree to zero nodes and resets the tree's meta data
+func (t *tree[T]) Clear() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.tree.Clear()
+}
+
+// Len returns the current node count
+func (t *tree[T]) Len() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Len()
+}
+
+// Empty returns true if the current node count is zero
+func (t *tree[T]) Empty() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Empty()
+}
+
+// Chan returns a channel which iterates from the front to the back of a snapshot of the tree
+func (t *tree[T]) Chan(n int) <-chan T {
+	return t.ChanContext(context.Background(), n)
+}
+
+// ChanBack returns a channel which iterates from the back to the front of a snapshot of the tree
+func (t *tree[T]) ChanBack(n int) <-chan T {
+	return t.ChanBackContext(context.Background(), n)
+}
+
+// ChanContext returns a channel which iterates from the front to the back of a snapshot of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := t.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for _, v := range s {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ChanBackContext returns a channel which iterates from the back to the front of a snapshot of the tree and which is closed early if the given context is done
+func (t *tree[T]) ChanBackContext(ctx context.Context, n int) <-chan T {
+	ch := make(chan T, n)
+	s := t.Slice()
+
+	go func() {
+		defer close(ch)
+
+		for i := len(s) - 1; i > -1; i-- {
+			select {
+			case ch <- s[i]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Iter returns an iterator which starts at the front of a snapshot of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) Iter() GenericTree.Iterator[T] {
+	return newIterator(t.Slice(), 0)
+}
+
+// IterBack returns an iterator which starts at the back of a snapshot of the tree, or nil if there are no nodes in the tree
+func (t *tree[T]) IterBack() GenericTree.Iterator[T] {
+	s := t.Slice()
+
+	return newIterator(s, len(s)-1)
+}
+
+// All returns a sequence which iterates from the front to the back of a snapshot of the tree
+func (t *tree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range t.Slice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates from the back to the front of a snapshot of the tree
+func (t *tree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s := t.Slice()
+
+		for i := len(s) - 1; i > -1; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first value of the tree and true, or false if there is no value
+func (t *tree[T]) First() (T, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.First()
+}
+
+// Last returns the last value of the tree and true, or false if there is no value
+func (t *tree[T]) Last() (T, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Last()
+}
+
+// Get returns the value of the node identified by the given id value and true, or false if there is no such node
+func (t *tree[T]) Get(id T) (T, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Get(id)
+}
+
+// GetFunc returns the value of the first node selected by the given function and true, or false if there is no such node
+func (t *tree[T]) GetFunc(m func(v T) bool) (T, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.GetFunc(m)
+}
+
+// Set sets the value of the node identified by the given id value and returns true, or false if there is no such node
+func (t *tree[T]) Set(id T, v T) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.tree.Set(id, v)
+}
+
+// SetFunc sets the value of the first node selected by the given function and returns true, or false if there is no such node
+func (t *tree[T]) SetFunc(m func(v T) bool, v T) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.tree.SetFunc(m, v)
+}
+
+// Contains returns true if a node identified by the given id value exists in the tree, or false if it does not
+func (t *tree[T]) Contains(id T) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Contains(id)
+}
+
+// Copy returns an exact copy of the tree which is again thread-safe
+func (t *tree[T]) Copy() GenericTree.Tree[T] {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return NewOf(t.tree.Copy())
+}
+
+// Slice returns a copy of the tree as a slice
+func (t *tree[T]) Slice() []T {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.tree.Slice()
+}
+
+// Insert inserts a new node into the tree with the given value
+func (t *tree[T]) Insert(v T) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.tree.Insert(v)
+}
+
+// Remove removes the node identified by the given id value and returns its value and true, or false if there is no such node
+func (t *tree[T]) Remove(id T) (T, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.tree.Remove(id)
+}
+
+// Pop removes the last node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Pop() (T, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.tree.Pop()
+}
+
+// Shift removes the first node and returns its value and true, or false if there is no such node
+func (t *tree[T]) Shift() (T, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.tree.Shift()
+}
+
+// InsertIfAbsent inserts a new node with the given value and returns true if no node is identified by the value, or false if there is such a node
+func (t *tree[T]) InsertIfAbsent(v T) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.tree.Contains(v) {
+		return false
+	}
+
+	t.tree.Insert(v)
+
+	return true
+}
+
+// PopIf removes the last node and returns its value and true if it is selected by the given function, or false if it is not or if there is no such node
+func (t *tree[T]) PopIf(m func(v T) bool) (T, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if v, ok := t.tree.Last(); !ok || !m(v) {
+		var v T
+
+		return v, false
+	}
+
+	return t.tree.Pop()
+}
+
+// ShiftIf removes the first node and returns its value and true if it is selected by the given function, or false if it is not or if there is no such node
+func (t *tree[T]) ShiftIf(m func(v T) bool) (T, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if v, ok := t.tree.First(); !ok || !m(v) {
+		var v T
+
+		return v, false
+	}
+
+	return t.tree.Shift()
+}
+
+// Do calls the given function with the wrapped tree while the tree is locked exclusively, which allows arbitrary atomic compound operations
+// The wrapped tree must not be used after the given function returns.
+func (t *tree[T]) Do(f func(t GenericTree.Tree[T])) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	f(t.tree)
+}
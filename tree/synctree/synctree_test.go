@@ -0,0 +1,261 @@
+package synctree
+
+import (
+	"sync"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	Tree "github.com/zimmski/container/tree"
+	"github.com/zimmski/container/tree/avltree"
+	"github.com/zimmski/container/tree/binarysearchtree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+	"github.com/zimmski/container/tree/skiplist"
+)
+
+func TestRunAllTests(t *testing.T) {
+	tt := &Tree.TreeTest{
+		New: func(t *testing.T) Tree.Tree {
+			return New(binarysearchtree.New(func(a, b interface{}) int {
+				switch {
+				case a.(int) == b.(int):
+					return 0
+				case a.(int) < b.(int):
+					return -1
+				default:
+					return 1
+				}
+			}))
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestRunAllGenericTests(t *testing.T) {
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			return NewOf(avltree.NewOrdered[int]())
+		},
+	}
+
+	tt.Run(t)
+}
+
+func TestConcurrentSuites(t *testing.T) {
+	// all suites share one tree to stress the lock while the suites run on their own trees
+	shared := NewOf(avltree.NewOrdered[int]())
+
+	tt := &GenericTree.TreeTest{
+		New: func(t *testing.T) GenericTree.Tree[int] {
+			shared.Insert(shared.Len())
+			shared.Shift()
+
+			return NewOf(avltree.NewOrdered[int]())
+		},
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			tt.TestBasic(t)
+			tt.TestIterator(t)
+			tt.TestSeq(t)
+			tt.TestSlice(t)
+			tt.TestRemove(t)
+			tt.TestClear(t)
+			tt.TestCopy(t)
+			tt.TestContains(t)
+			tt.TestGetSet(t)
+			tt.TestFuncs(t)
+		}()
+	}
+
+	wg.Wait()
+
+	Equal(t, shared.Len(), 0)
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	tr := NewOf(avltree.NewOrdered[int]())
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				v := g*1000 + i
+
+				tr.Insert(v)
+				tr.Insert(-v - 1)
+
+				// snapshots are sorted even while other goroutines modify the tree
+				if i%20 == 0 {
+					p := -1 << 31
+
+					for it := tr.Iter(); it != nil; it = it.Next() {
+						True(t, p < it.Get())
+
+						p = it.Get()
+					}
+
+					for range tr.All() {
+					}
+					for range tr.Chan(1) {
+					}
+				}
+
+				tr.ShiftIf(func(w int) bool {
+					return w < 0
+				})
+				tr.Contains(v)
+				tr.Len()
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	// every positive value is never removed
+	for g := 0; g < 8; g++ {
+		for i := 0; i < 200; i++ {
+			True(t, tr.Contains(g*1000+i))
+		}
+	}
+}
+
+func TestConcurrentCopy(t *testing.T) {
+	// copies are made under the read lock and must not modify the wrapped tree
+	tr := NewOf(skiplist.NewOrdered[int]())
+
+	for i := 0; i < 100; i++ {
+		tr.Insert(i)
+	}
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 20; i++ {
+				c := tr.Copy()
+
+				Equal(t, c.Len(), 100)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestInsertIfAbsent(t *testing.T) {
+	tr := NewOf(avltree.NewOrdered[int]())
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	inserted := 0
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				if tr.InsertIfAbsent(i) {
+					mutex.Lock()
+					inserted++
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	Equal(t, inserted, 100)
+	Equal(t, tr.Len(), 100)
+
+	for i, v := range tr.Slice() {
+		Equal(t, v, i)
+	}
+}
+
+func TestPopIfShiftIf(t *testing.T) {
+	tr := NewOf(avltree.NewOrdered[int]())
+
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+
+	_, ok := tr.PopIf(even)
+	False(t, ok)
+	_, ok = tr.ShiftIf(even)
+	False(t, ok)
+
+	for i := 0; i < 5; i++ {
+		tr.Insert(i)
+	}
+
+	v, ok := tr.PopIf(even)
+	True(t, ok)
+	Equal(t, v, 4)
+	v, ok = tr.PopIf(even)
+	False(t, ok)
+	Equal(t, v, 0)
+
+	v, ok = tr.ShiftIf(even)
+	True(t, ok)
+	Equal(t, v, 0)
+	v, ok = tr.ShiftIf(even)
+	False(t, ok)
+	Equal(t, v, 0)
+
+	Equal(t, tr.Slice(), []int{1, 2, 3})
+
+	tr.Do(func(t GenericTree.Tree[int]) {
+		t.Insert(4)
+		t.Insert(0)
+	})
+
+	Equal(t, tr.Slice(), []int{0, 1, 2, 3, 4})
+}
+
+func TestSnapshotIterator(t *testing.T) {
+	tr := NewOf(avltree.NewOrdered[int]())
+
+	for i := 0; i < 3; i++ {
+		tr.Insert(i)
+	}
+
+	iter := tr.Iter()
+
+	// modifications after the creation of the iterator do not change the snapshot
+	tr.Insert(3)
+	tr.Shift()
+
+	Equal(t, iter.Get(), 0)
+	Equal(t, iter.Next().Get(), 1)
+	Equal(t, iter.Next().Get(), 2)
+	Nil(t, iter.Next())
+
+	iter = tr.IterBack()
+
+	Equal(t, iter.Get(), 3)
+	Equal(t, iter.Previous().Get(), 2)
+	Equal(t, iter.Previous().Get(), 1)
+	Nil(t, iter.Previous())
+}
@@ -1,10 +1,13 @@
 package tree
 
 import (
+	"context"
+	"slices"
 	"sort"
 	"testing"
 
 	. "github.com/zimmski/container/test/assert"
+	"github.com/zimmski/go-leak"
 )
 
 // VRaw holds the unsorted value for basic tree tests
@@ -16,6 +19,18 @@ var V = []interface{}{1, 2, 3, 4, 5, 6}
 // VLen is the length of V
 var VLen = len(V)
 
+// CompareInts compares two int values for the basic tree tests
+func CompareInts(a, b interface{}) int {
+	switch {
+	case a.(int) == b.(int):
+		return 0
+	case a.(int) < b.(int):
+		return -1
+	default:
+		return 1
+	}
+}
+
 // TreeTest is the base for all tests of trees
 type TreeTest struct {
 	New func(t *testing.T) Tree
@@ -28,6 +43,8 @@ func (tt *TreeTest) Run(t *testing.T) {
 	tt.TestBasic(t)
 	tt.TestIterator(t)
 	tt.TestChannels(t)
+	tt.TestChannelsContext(t)
+	tt.TestSeq(t)
 	tt.TestSlice(t)
 	tt.TestRemove(t)
 	tt.TestClear(t)
@@ -415,6 +432,151 @@ func (tt *TreeTest) TestChannels(t *testing.T) {
 	Equal(t, i, -1)
 }
 
+// TestChannelsContext tests buffered and cancellable tree channels
+func (tt *TreeTest) TestChannelsContext(t *testing.T) {
+	tr := tt.NewFilledTree(t)
+
+	// buffered channels
+	ch := tr.Chan(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i := 0
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	ch = tr.ChanBack(VLen)
+	Equal(t, cap(ch), VLen)
+
+	i = VLen - 1
+
+	for v := range ch {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// channels with a context which is never done
+	i = 0
+
+	for v := range tr.ChanContext(context.Background(), 0) {
+		Equal(t, v, V[i])
+
+		i++
+	}
+
+	Equal(t, i, VLen)
+
+	i = VLen - 1
+
+	for v := range tr.ChanBackContext(context.Background(), 0) {
+		Equal(t, v, V[i])
+
+		i--
+	}
+
+	Equal(t, i, -1)
+
+	// consumers which stop early
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := tr.ChanContext(ctx, 0)
+		Equal(t, <-ch, V[0])
+
+		chBack := tr.ChanBackContext(ctx, 1)
+		Equal(t, <-chBack, V[VLen-1])
+
+		cancel()
+
+		// wait until the goroutines noticed the cancellation and closed the channels
+		i := 0
+
+		for range ch {
+			i++
+		}
+		for range chBack {
+			i++
+		}
+
+		True(t, i < 2*VLen-3, "goroutines did not stop after the cancellation")
+	}))
+
+	// contexts which are already done
+	Equal(t, 0, leak.GoRoutineLeaks(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for range tr.ChanContext(ctx, 0) {
+		}
+		for range tr.ChanBackContext(ctx, 0) {
+		}
+	}))
+}
+
+// TestSeq tests tree sequences
+func (tt *TreeTest) TestSeq(t *testing.T) {
+	// empty sequences
+	tr := tt.New(t)
+
+	Equal(t, len(slices.Collect(tr.All())), 0)
+	Equal(t, len(slices.Collect(tr.Backward())), 0)
+
+	// full sequences
+	tr = tt.NewFilledTree(t)
+
+	Equal(t, slices.Collect(tr.All()), V)
+
+	r := slices.Clone(V)
+	slices.Reverse(r)
+	Equal(t, slices.Collect(tr.Backward()), r)
+
+	// sequences stop early
+	i := 0
+
+	for v := range tr.All() {
+		Equal(t, v, V[i])
+
+		if i == 2 {
+			break
+		}
+
+		i++
+	}
+
+	Equal(t, i, 2)
+
+	i = VLen - 1
+
+	for v := range tr.Backward() {
+		Equal(t, v, V[i])
+
+		if i == VLen-3 {
+			break
+		}
+
+		i--
+	}
+
+	Equal(t, i, VLen-3)
+
+	// sequences can be reused and see modifications of the tree
+	s := tr.All()
+
+	Equal(t, slices.Collect(s), V)
+
+	tr.Pop()
+
+	Equal(t, slices.Collect(s), V[:VLen-1])
+}
+
 // TestSlice tests converting the tree to slice
 func (tt *TreeTest) TestSlice(t *testing.T) {
 	tr := tt.New(t)
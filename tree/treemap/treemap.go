@@ -0,0 +1,298 @@
+package treemap
+
+import (
+	"cmp"
+	"iter"
+
+	GenericTree "github.com/zimmski/container/tree/generic"
+)
+
+// Entry holds a key and its value in the tree of a tree map
+type Entry[K any, V any] struct {
+	Key   K // The key of the entry which orders the entry in the tree
+	Value V // The value of the entry
+}
+
+// treeMap holds a map which keeps its keys ordered with a tree
+// Every key is stored only once. Values are changed in place so the tree is only restructured if a key is added or removed.
+type treeMap[K any, V any] struct {
+	tree    GenericTree.Tree[*Entry[K, V]]                                           // The tree holding the entries of the map
+	newTree func(compare func(a, b *Entry[K, V]) int) GenericTree.Tree[*Entry[K, V]] // Create a new tree for copies of the map
+	compare func(a, b K) int                                                         // Compare two keys for the map order
+}
+
+// New returns a new tree map which orders its keys with the given compare function and stores its entries in a tree created by newTree
+// The tree can be any tree implementation, e.g. New(cmp.Compare[int], avltree.New[*treemap.Entry[int, string]]). Bound queries and range scans are done in O(log n) if the tree implements NavigableTree and in O(n) otherwise.
+func New[K any, V any, T GenericTree.Tree[*Entry[K, V]]](compare func(a, b K) int, newTree func(compare func(a, b *Entry[K, V]) int) T) *treeMap[K, V] {
+	t := &treeMap[K, V]{
+		newTree: func(compare func(a, b *Entry[K, V]) int) GenericTree.Tree[*Entry[K, V]] {
+			return newTree(compare)
+		},
+		compare: compare,
+	}
+
+	t.tree = t.newTree(t.compareEntries)
+
+	return t
+}
+
+// NewOrdered returns a new tree map for ordered keys which are compared with cmp.Compare and stores its entries in a tree created by newTree
+func NewOrdered[K cmp.Ordered, V any, T GenericTree.Tree[*Entry[K, V]]](newTree func(compare func(a, b *Entry[K, V]) int) T) *treeMap[K, V] {
+	return New(cmp.Compare[K], newTree)
+}
+
+// compareEntries compares two entries by their keys
+func (t *treeMap[K, V]) compareEntries(a, b *Entry[K, V]) int {
+	return t.compare(a.Key, b.Key)
+}
+
+// Clear resets the map to zero entries
+func (t *treeMap[K, V]) Clear() {
+	t.tree.Clear()
+}
+
+// Len returns the current entry count
+func (t *treeMap[K, V]) Len() int {
+	return t.tree.Len()
+}
+
+// Empty returns true if the current entry count is zero
+func (t *treeMap[K, V]) Empty() bool {
+	return t.tree.Empty()
+}
+
+// getEntry returns the entry of the given key, or nil if there is no such entry
+func (t *treeMap[K, V]) getEntry(k K) *Entry[K, V] {
+	e, _ := t.tree.Get(&Entry[K, V]{Key: k})
+
+	return e
+}
+
+// seek returns an iterator which starts at the entry with the least key greater than or equal to the given key, or nil if there is no such entry
+func (t *treeMap[K, V]) seek(k K) GenericTree.Iterator[*Entry[K, V]] {
+	if nt, ok := t.tree.(GenericTree.NavigableTree[*Entry[K, V]]); ok {
+		return nt.IterFrom(&Entry[K, V]{Key: k})
+	}
+
+	for iter := t.tree.Iter(); iter != nil; iter = iter.Next() {
+		if t.compare(iter.Get().Key, k) >= 0 {
+			return iter
+		}
+	}
+
+	return nil
+}
+
+// iterEntry returns the key and value of the iterator's current entry and true, or false if the iterator is nil
+func iterEntry[K any, V any](iter GenericTree.Iterator[*Entry[K, V]]) (K, V, bool) {
+	if iter == nil {
+		var k K
+		var v V
+
+		return k, v, false
+	}
+
+	e := iter.Get()
+
+	return e.Key, e.Value, true
+}
+
+// Get returns the value of the given key and true, or false if there is no such key
+func (t *treeMap[K, V]) Get(k K) (V, bool) {
+	e := t.getEntry(k)
+
+	if e == nil {
+		var v V
+
+		return v, false
+	}
+
+	return e.Value, true
+}
+
+// Put sets the value of the given key and returns the previous value and true, or false if the key was not in the map
+func (t *treeMap[K, V]) Put(k K, v V) (V, bool) {
+	e := t.getEntry(k)
+
+	if e == nil {
+		t.tree.Insert(&Entry[K, V]{
+			Key:   k,
+			Value: v,
+		})
+
+		var p V
+
+		return p, false
+	}
+
+	p := e.Value
+
+	e.Value = v
+
+	return p, true
+}
+
+// Delete removes the given key and returns its value and true, or false if there is no such key
+func (t *treeMap[K, V]) Delete(k K) (V, bool) {
+	e, ok := t.tree.Remove(&Entry[K, V]{Key: k})
+
+	if !ok {
+		var v V
+
+		return v, false
+	}
+
+	return e.Value, true
+}
+
+// Contains returns true if the given key exists in the map, or false if it does not
+func (t *treeMap[K, V]) Contains(k K) bool {
+	return t.getEntry(k) != nil
+}
+
+// First returns the least key and its value and true, or false if there is no entry
+func (t *treeMap[K, V]) First() (K, V, bool) {
+	return iterEntry(t.tree.Iter())
+}
+
+// Last returns the greatest key and its value and true, or false if there is no entry
+func (t *treeMap[K, V]) Last() (K, V, bool) {
+	return iterEntry(t.tree.IterBack())
+}
+
+// Floor returns the greatest key less than or equal to the given key and its value and true, or false if there is no such key
+func (t *treeMap[K, V]) Floor(k K) (K, V, bool) {
+	iter := t.seek(k)
+
+	if iter == nil {
+		iter = t.tree.IterBack()
+	} else if t.compare(iter.Get().Key, k) != 0 {
+		iter = iter.Previous()
+	}
+
+	return iterEntry(iter)
+}
+
+// Ceiling returns the least key greater than or equal to the given key and its value and true, or false if there is no such key
+func (t *treeMap[K, V]) Ceiling(k K) (K, V, bool) {
+	return iterEntry(t.seek(k))
+}
+
+// Lower returns the greatest key less than the given key and its value and true, or false if there is no such key
+func (t *treeMap[K, V]) Lower(k K) (K, V, bool) {
+	iter := t.seek(k)
+
+	if iter == nil {
+		iter = t.tree.IterBack()
+	} else {
+		iter = iter.Previous()
+	}
+
+	return iterEntry(iter)
+}
+
+// Higher returns the least key greater than the given key and its value and true, or false if there is no such key
+func (t *treeMap[K, V]) Higher(k K) (K, V, bool) {
+	iter := t.seek(k)
+
+	if iter != nil && t.compare(iter.Get().Key, k) == 0 {
+		iter = iter.Next()
+	}
+
+	return iterEntry(iter)
+}
+
+// All returns a sequence which iterates over all keys and their values in ascending key order
+func (t *treeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for iter := t.tree.Iter(); iter != nil; iter = iter.Next() {
+			e := iter.Get()
+
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence which iterates over all keys and their values in descending key order
+func (t *treeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for iter := t.tree.IterBack(); iter != nil; iter = iter.Previous() {
+			e := iter.Get()
+
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns a sequence which iterates over all keys between lo and hi inclusively and their values in ascending key order
+func (t *treeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for iter := t.seek(lo); iter != nil; iter = iter.Next() {
+			e := iter.Get()
+
+			if t.compare(e.Key, hi) > 0 || !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence which iterates over all keys in ascending order
+func (t *treeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence which iterates over all values in ascending order of their keys
+func (t *treeMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range t.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Copy returns a copy of the map with its own tree and entries
+func (t *treeMap[K, V]) Copy() *treeMap[K, V] {
+	t2 := &treeMap[K, V]{
+		newTree: t.newTree,
+		compare: t.compare,
+	}
+
+	t2.tree = t2.newTree(t2.compareEntries)
+
+	entries := t.tree.Slice()
+
+	// insert the medians first so that unbalanced trees stay balanced
+	var insert func(lo, hi int)
+	insert = func(lo, hi int) {
+		if lo > hi {
+			return
+		}
+
+		m := lo + (hi-lo)/2
+
+		t2.tree.Insert(&Entry[K, V]{
+			Key:   entries[m].Key,
+			Value: entries[m].Value,
+		})
+
+		insert(lo, m-1)
+		insert(m+1, hi)
+	}
+
+	insert(0, len(entries)-1)
+
+	return t2
+}
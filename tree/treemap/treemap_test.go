@@ -0,0 +1,331 @@
+package treemap
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	. "github.com/zimmski/container/test/assert"
+
+	"github.com/zimmski/container/tree/avltree"
+	"github.com/zimmski/container/tree/binarysearchtree"
+	GenericTree "github.com/zimmski/container/tree/generic"
+	"github.com/zimmski/container/tree/redblacktree"
+	"github.com/zimmski/container/tree/skiplist"
+	"github.com/zimmski/container/tree/synctree"
+)
+
+type intEntry = *Entry[int, int]
+
+var variants = []struct {
+	name string
+	new  func() *treeMap[int, int]
+}{
+	{"avltree", func() *treeMap[int, int] {
+		return New(cmp.Compare[int], avltree.New[intEntry])
+	}},
+	{"binarysearchtree", func() *treeMap[int, int] {
+		return NewOrdered[int, int](binarysearchtree.New[intEntry])
+	}},
+	{"redblacktree", func() *treeMap[int, int] {
+		return NewOrdered[int, int](redblacktree.New[intEntry])
+	}},
+	{"skiplist", func() *treeMap[int, int] {
+		return NewOrdered[int, int](func(compare func(a, b intEntry) int) GenericTree.Tree[intEntry] {
+			return skiplist.New(compare, skiplist.WithSource[intEntry](rand.NewSource(1)))
+		})
+	}},
+	{"synctree", func() *treeMap[int, int] {
+		// the thread-safe tree is not navigable which tests the linear fallbacks
+		return NewOrdered[int, int](func(compare func(a, b intEntry) int) GenericTree.Tree[intEntry] {
+			return synctree.NewOf[intEntry](avltree.New(compare))
+		})
+	}},
+}
+
+// countingTree counts the restructuring operations of a tree
+type countingTree struct {
+	GenericTree.Tree[intEntry]
+
+	inserts int
+	removes int
+	first   int
+}
+
+func (c *countingTree) Insert(v intEntry) {
+	if c.inserts == 0 {
+		c.first = v.Key
+	}
+
+	c.inserts++
+
+	c.Tree.Insert(v)
+}
+
+func (c *countingTree) Remove(id intEntry) (intEntry, bool) {
+	c.removes++
+
+	return c.Tree.Remove(id)
+}
+
+func (c *countingTree) Set(id intEntry, v intEntry) bool {
+	c.removes++
+	c.inserts++
+
+	return c.Tree.Set(id, v)
+}
+
+// compareWithSlice checks all queries of the given map against the given sorted keys which map to their negated values
+func compareWithSlice(t *testing.T, m *treeMap[int, int], keys []int) {
+	Equal(t, m.Len(), len(keys))
+	Equal(t, m.Empty(), len(keys) == 0)
+
+	Equal(t, append([]int{}, slices.Collect(m.Keys())...), keys)
+
+	values := []int{}
+	for _, k := range keys {
+		values = append(values, -k)
+	}
+	Equal(t, append([]int{}, slices.Collect(m.Values())...), values)
+
+	backward := []int{}
+	for k, v := range m.Backward() {
+		Equal(t, v, -k)
+
+		backward = append(backward, k)
+	}
+	slices.Reverse(backward)
+	Equal(t, backward, keys)
+
+	check := func(k, v int, ok bool, i int) {
+		if i < 0 || i >= len(keys) {
+			False(t, ok)
+			Equal(t, k, 0)
+			Equal(t, v, 0)
+		} else {
+			True(t, ok)
+			Equal(t, k, keys[i])
+			Equal(t, v, -keys[i])
+		}
+	}
+
+	k, v, ok := m.First()
+	check(k, v, ok, 0)
+	k, v, ok = m.Last()
+	check(k, v, ok, len(keys)-1)
+
+	for q := -2; q <= 2*len(keys)+2; q++ {
+		lower := sort.SearchInts(keys, q)
+		upper := sort.SearchInts(keys, q+1)
+
+		k, v, ok = m.Floor(q)
+		check(k, v, ok, upper-1)
+		k, v, ok = m.Ceiling(q)
+		check(k, v, ok, lower)
+		k, v, ok = m.Lower(q)
+		check(k, v, ok, lower-1)
+		k, v, ok = m.Higher(q)
+		check(k, v, ok, upper)
+
+		v, ok = m.Get(q)
+		Equal(t, ok, lower != upper)
+		Equal(t, m.Contains(q), ok)
+		if ok {
+			Equal(t, v, -q)
+		}
+
+		for hi := q - 1; hi <= q+5; hi++ {
+			r := []int{}
+			for k, v := range m.Range(q, hi) {
+				Equal(t, v, -k)
+
+				r = append(r, k)
+			}
+
+			expected := []int{}
+			if q <= hi {
+				expected = append(expected, keys[lower:sort.SearchInts(keys, hi+1)]...)
+			}
+			Equal(t, r, expected)
+		}
+	}
+}
+
+func TestBasic(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			m := variant.new()
+
+			compareWithSlice(t, m, []int{})
+
+			for _, k := range []int{10, 4, 6, 2, 8, 0} {
+				_, ok := m.Put(k, k)
+				False(t, ok)
+			}
+
+			for k := 0; k <= 10; k += 2 {
+				p, ok := m.Put(k, -k)
+				True(t, ok)
+				Equal(t, p, k)
+			}
+
+			compareWithSlice(t, m, []int{0, 2, 4, 6, 8, 10})
+
+			v, ok := m.Delete(4)
+			True(t, ok)
+			Equal(t, v, -4)
+
+			v, ok = m.Delete(4)
+			False(t, ok)
+			Equal(t, v, 0)
+
+			compareWithSlice(t, m, []int{0, 2, 6, 8, 10})
+
+			// sequences stop early
+			n := 0
+			for range m.Range(0, 10) {
+				n++
+
+				if n == 2 {
+					break
+				}
+			}
+			Equal(t, n, 2)
+
+			m.Clear()
+
+			compareWithSlice(t, m, []int{})
+		})
+	}
+}
+
+func TestPutInPlace(t *testing.T) {
+	var tr *countingTree
+
+	m := NewOrdered[int, int](func(compare func(a, b intEntry) int) *countingTree {
+		tr = &countingTree{
+			Tree: avltree.New(compare),
+		}
+
+		return tr
+	})
+
+	for i := 0; i < 10; i++ {
+		m.Put(i, i)
+	}
+
+	Equal(t, tr.inserts, 10)
+
+	for i := 0; i < 10; i++ {
+		m.Put(i, -i)
+	}
+
+	Equal(t, tr.inserts, 10)
+	Equal(t, tr.removes, 0)
+
+	compareWithSlice(t, m, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+}
+
+func TestCopy(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			m := variant.new()
+
+			for i := 0; i < 100; i++ {
+				m.Put(i, -i)
+			}
+
+			c := m.Copy()
+
+			keys := slices.Collect(m.Keys())
+
+			compareWithSlice(t, c, keys)
+
+			// copies do not share entries
+			c.Put(0, 100)
+			c.Delete(1)
+			c.Put(100, -100)
+
+			compareWithSlice(t, m, keys)
+
+			v, _ := c.Get(0)
+			Equal(t, v, 100)
+			False(t, c.Contains(1))
+			True(t, c.Contains(100))
+		})
+	}
+
+	// copies insert the median first
+	var tr *countingTree
+
+	m := NewOrdered[int, int](func(compare func(a, b intEntry) int) *countingTree {
+		tr = &countingTree{
+			Tree: binarysearchtree.New(compare),
+		}
+
+		return tr
+	})
+
+	for i := 0; i < 1000; i++ {
+		m.Put(i, -i)
+	}
+
+	Equal(t, tr.first, 0)
+
+	c := m.Copy()
+
+	Equal(t, tr.first, 499)
+	Equal(t, tr.inserts, 1000)
+
+	compareWithSlice(t, c, slices.Collect(m.Keys()))
+}
+
+func TestRandomOperations(t *testing.T) {
+	for _, variant := range variants {
+		t.Run(variant.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+
+			m := variant.new()
+			s := map[int]int{}
+
+			for i := 0; i < 500; i++ {
+				k := r.Intn(40)
+
+				switch r.Intn(3) {
+				case 0, 1:
+					p, ok := m.Put(k, -k)
+
+					_, found := s[k]
+					Equal(t, ok, found)
+					if ok {
+						Equal(t, p, -k)
+					}
+
+					s[k] = -k
+				case 2:
+					v, ok := m.Delete(k)
+
+					_, found := s[k]
+					Equal(t, ok, found)
+					if ok {
+						Equal(t, v, -k)
+					}
+
+					delete(s, k)
+				}
+
+				if i%25 == 0 {
+					keys := []int{}
+					for k := range s {
+						keys = append(keys, k)
+					}
+					sort.Ints(keys)
+
+					compareWithSlice(t, m, keys)
+				}
+			}
+		})
+	}
+}
@@ -7,17 +7,27 @@ import (
 // Panic catcher stolen from https://groups.google.com/forum/#!topic/golang-nuts/Hg_u6fdTx0I
 
 // Panics returns true if function f panics with parameters p.
+// Variadic parameters of f can be omitted or passed one by one.
 func Panics(f interface{}, p ...interface{}) bool {
 	fv := reflect.ValueOf(f)
 	ft := reflect.TypeOf(f)
 
-	if ft.NumIn() != len(p) {
+	if ft.IsVariadic() {
+		if len(p) < ft.NumIn()-1 {
+			panic("wrong argument count")
+		}
+	} else if ft.NumIn() != len(p) {
 		panic("wrong argument count")
 	}
 
 	pv := make([]reflect.Value, len(p))
 	for i, v := range p {
-		if reflect.TypeOf(v) != ft.In(i) {
+		it := ft.In(min(i, ft.NumIn()-1))
+		if ft.IsVariadic() && i >= ft.NumIn()-1 {
+			it = it.Elem()
+		}
+
+		if reflect.TypeOf(v) != it {
 			panic("wrong argument type")
 		}
 		pv[i] = reflect.ValueOf(v)